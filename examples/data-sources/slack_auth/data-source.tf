@@ -0,0 +1,11 @@
+# Read in the identity the provider authenticates as
+data "slack_auth" "current" {}
+
+output "team_id" {
+  value = data.slack_auth.current.team_id
+}
+
+# Read in the identity of the provider's user token
+data "slack_auth" "user" {
+  token_type = "user"
+}
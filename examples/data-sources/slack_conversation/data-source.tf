@@ -2,3 +2,9 @@
 data "slack_conversation" "example" {
  id = "C99ZZ999ZZZ"
 }
+
+# Read in a existing Slack conversation by name
+data "slack_conversation" "by_name" {
+ name             = "general"
+ include_archived = false
+}
@@ -0,0 +1,18 @@
+# Read in every active public and private team channel
+data "slack_conversations" "teams" {
+  types            = ["public_channel", "private_channel"]
+  exclude_archived = true
+  name_prefix      = "team-"
+}
+
+# Flag channels that do not follow the naming convention
+data "slack_conversations" "all" {
+  exclude_archived = true
+}
+
+output "nonconforming_channels" {
+  value = [
+    for c in data.slack_conversations.all.conversations : c.name
+    if length(regexall("^(team|proj|ops)-", c.name)) == 0
+  ]
+}
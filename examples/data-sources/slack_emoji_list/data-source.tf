@@ -0,0 +1,6 @@
+# Read in every custom emoji in the workspace
+data "slack_emoji_list" "all" {}
+
+output "custom_emoji_names" {
+  value = keys(data.slack_emoji_list.all.emoji)
+}
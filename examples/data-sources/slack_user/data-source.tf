@@ -2,3 +2,23 @@
 data "slack_user" "example" {
  id = "U99ZZ9USZ9Z00"
 }
+
+# Read in a existing Slack user by email address
+data "slack_user" "by_email" {
+ email = "jane.doe@example.com"
+}
+
+# Read in a existing Slack user by username
+data "slack_user" "by_name" {
+ name = "jane.doe"
+}
+
+# Read in a existing Slack user's custom profile fields by label
+data "slack_user" "with_labels" {
+ id           = "U99ZZ9USZ9Z00"
+ field_labels = true
+}
+
+output "manager_id" {
+ value = data.slack_user.with_labels.profile.fields["Manager"].value
+}
@@ -0,0 +1,9 @@
+# Read in a existing Slack user group by ID
+data "slack_usergroup" "example" {
+ id = "S99ZZ999ZZZ"
+}
+
+# Read in a existing Slack user group by handle
+data "slack_usergroup" "oncall" {
+ handle = "oncall"
+}
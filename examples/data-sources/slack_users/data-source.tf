@@ -0,0 +1,12 @@
+# Read in every active, human Slack user on the engineering team
+data "slack_users" "engineers" {
+  filter = {
+    is_bot      = false
+    deleted     = false
+    title_regex = "(?i)engineer"
+  }
+}
+
+output "engineer_ids" {
+  value = data.slack_users.engineers.users[*].id
+}
@@ -0,0 +1,12 @@
+# Exchange a refresh token for a short lived access token without storing
+# either in the state
+ephemeral "slack_oauth_token" "rotated" {
+  client_id     = var.slack_client_id
+  client_secret = var.slack_client_secret
+  refresh_token = var.slack_refresh_token
+}
+
+provider "slack" {
+  alias = "rotated"
+  token = ephemeral.slack_oauth_token.rotated.access_token
+}
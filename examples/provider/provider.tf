@@ -17,6 +17,13 @@ provider "slack" {
   token = var.slack_token
 }
 
+# Alternatively, read the token from a file or a command such as a password
+# manager CLI, keeping it out of the environment and variables
+provider "slack" {
+  alias         = "command"
+  token_command = "op read op://infra/slack/token"
+}
+
 # Read in a existing Slack user
 data "slack_user" "example" {
  id = "U99ZZ9USZ9Z00"
@@ -0,0 +1,3 @@
+# Bookmarks can be imported using the conversation ID and the bookmark ID,
+# separated by a slash
+terraform import slack_bookmark.dashboard C99ZZ999ZZZ/Bk99ZZ999ZZZ
@@ -0,0 +1,7 @@
+# Link a team channel to its dashboard
+resource "slack_bookmark" "dashboard" {
+  channel_id = "C99ZZ999ZZZ"
+  title      = "Service dashboard"
+  link       = "https://grafana.example.com/d/service"
+  emoji      = ":chart_with_upwards_trend:"
+}
@@ -0,0 +1,2 @@
+# Conversations can be imported using their ID
+terraform import slack_conversation.example C99ZZ999ZZZ
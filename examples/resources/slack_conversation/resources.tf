@@ -0,0 +1,7 @@
+# Manage a Slack channel
+resource "slack_conversation" "example" {
+  name       = "team-example"
+  is_private = false
+  topic      = "Everything about the example team"
+  purpose    = "Coordinate work for the example team"
+}
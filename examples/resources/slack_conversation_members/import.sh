@@ -0,0 +1,2 @@
+# Conversation members can be imported using the conversation ID
+terraform import slack_conversation_members.example C99ZZ999ZZZ
@@ -0,0 +1,17 @@
+# Manage exactly who belongs in a Slack channel
+resource "slack_conversation_members" "example" {
+  conversation_id = "C99ZZ999ZZZ"
+  members = [
+    "U99ZZ9USZ9Z00",
+    "U99ZZ9USZ9Z01",
+  ]
+}
+
+# Only ensure that the listed users are present
+resource "slack_conversation_members" "additive" {
+  conversation_id = "C99ZZ999ZZY"
+  authoritative   = false
+  members = [
+    "U99ZZ9USZ9Z00",
+  ]
+}
@@ -0,0 +1,2 @@
+# Conversation purposes can be imported using the conversation ID
+terraform import slack_conversation_purpose.general C99ZZ999ZZZ
@@ -0,0 +1,5 @@
+# Enforce the purpose of a channel whose lifecycle is not managed by Terraform
+resource "slack_conversation_purpose" "general" {
+  conversation_id = "C99ZZ999ZZZ"
+  purpose         = "This is the one channel that will always include everyone."
+}
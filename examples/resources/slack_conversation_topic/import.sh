@@ -0,0 +1,2 @@
+# Conversation topics can be imported using the conversation ID
+terraform import slack_conversation_topic.general C99ZZ999ZZZ
@@ -0,0 +1,5 @@
+# Enforce the topic of a channel whose lifecycle is not managed by Terraform
+resource "slack_conversation_topic" "general" {
+  conversation_id = "C99ZZ999ZZZ"
+  topic           = "Company-wide announcements. See #help for questions."
+}
@@ -0,0 +1,2 @@
+# Emoji can be imported using their name
+terraform import slack_emoji.sev1 sev1
@@ -0,0 +1,14 @@
+# Add an incident severity emoji whose image Slack fetches from a URL, with
+# aliases
+resource "slack_emoji" "sev1" {
+  name    = "sev1"
+  url     = "https://assets.example.com/emoji/sev1.png"
+  aliases = ["severity-1"]
+}
+
+# Upload an emoji from a local image. This goes through emoji.add, which is
+# not part of Slack's published API and may reject the admin token.
+resource "slack_emoji" "sev2" {
+  name       = "sev2"
+  image_path = "${path.module}/emoji/sev2.png"
+}
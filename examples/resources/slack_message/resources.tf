@@ -0,0 +1,22 @@
+# Keep a read me first message in a Slack channel
+resource "slack_message" "readme" {
+  channel_id = "C99ZZ999ZZZ"
+  text       = "Read me first: see the runbook before paging on-call."
+}
+
+# Lay out a runbook message with Block Kit, with text as the notification
+# fallback
+resource "slack_message" "runbook" {
+  channel_id = "C99ZZ999ZZZ"
+  text       = "Incident runbook"
+  blocks = jsonencode([
+    {
+      type = "header"
+      text = { type = "plain_text", text = "Incident runbook" }
+    },
+    {
+      type = "section"
+      text = { type = "mrkdwn", text = "1. Declare the incident\n2. Page on-call\n3. Post updates here" }
+    },
+  ])
+}
@@ -0,0 +1,3 @@
+# Pins can be imported using the conversation ID and the message ts,
+# separated by a slash
+terraform import slack_pin.readme C99ZZ999ZZZ/1700000000.000100
@@ -0,0 +1,10 @@
+resource "slack_message" "readme" {
+  channel_id = "C99ZZ999ZZZ"
+  text       = "Read me first: see the runbook before paging on-call."
+}
+
+# Keep the read me first message pinned to the channel
+resource "slack_pin" "readme" {
+  channel_id = slack_message.readme.channel_id
+  timestamp  = slack_message.readme.id
+}
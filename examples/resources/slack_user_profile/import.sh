@@ -0,0 +1,2 @@
+# User profiles can be imported using the user ID
+terraform import slack_user_profile.jane U99ZZ999ZZZ
@@ -0,0 +1,10 @@
+# Reconcile a user's title and manager with the HR system. The manager
+# custom field takes the manager's user ID, keyed by the field's ID.
+resource "slack_user_profile" "jane" {
+  user_id = "U99ZZ999ZZZ"
+  title   = "Staff Engineer"
+
+  fields = {
+    "Xf06054AAA" = "U88YY888YYY"
+  }
+}
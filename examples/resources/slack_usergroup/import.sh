@@ -0,0 +1,2 @@
+# User groups can be imported using their ID
+terraform import slack_usergroup.example S99ZZ999ZZZ
@@ -0,0 +1,7 @@
+# Manage a Slack user group
+resource "slack_usergroup" "example" {
+  name        = "On-call"
+  handle      = "oncall"
+  description = "Whoever is on call this week"
+  channels    = ["C99ZZ999ZZZ"]
+}
@@ -0,0 +1,2 @@
+# User group members can be imported using the user group ID
+terraform import slack_usergroup_members.example S99ZZ999ZZZ
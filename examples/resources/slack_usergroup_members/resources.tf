@@ -0,0 +1,14 @@
+# Keep a user group in sync with every active engineer
+resource "slack_usergroup_members" "example" {
+  usergroup_id = "S99ZZ999ZZZ"
+
+  # Always include these users
+  users = ["U99ZZ9USZ9Z00"]
+
+  # Plus every non-bot, non-deleted user whose title mentions "Engineer"
+  filter = {
+    is_bot      = false
+    deleted     = false
+    title_regex = "(?i)engineer"
+  }
+}
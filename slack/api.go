@@ -0,0 +1,95 @@
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// slackResponse is a decoded Slack API response, reporting whether the call
+// succeeded.
+type slackResponse interface {
+	Err() error
+}
+
+// postForm calls the Slack API method at apiURL with the form encoded values
+// and decodes the result into response.
+func postForm(client httpDoer, apiURL, method string, values url.Values, response slackResponse) error {
+	req, err := http.NewRequest(http.MethodPost, apiURL+method, strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return doRequest(client, req, response)
+}
+
+// doRequest sends the request and decodes the result into response.
+func doRequest(client httpDoer, req *http.Request, response slackResponse) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return slack.StatusCodeError{Code: resp.StatusCode, Status: resp.Status}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return err
+	}
+	return response.Err()
+}
+
+// call calls a Slack API method the embedded client does not implement, with
+// the client's token.
+func (c *slackClient) call(method string, values url.Values, response slackResponse) error {
+	values.Set("token", c.token)
+	return postForm(c.httpClient, c.apiURL, method, values, response)
+}
+
+// upload calls a Slack API method the embedded client does not implement
+// with a multipart form carrying the values and a file, with the client's
+// token.
+func (c *slackClient) upload(method string, values url.Values, field, filename string, content []byte, response slackResponse) error {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for name, vs := range values {
+		for _, v := range vs {
+			if err := writer.WriteField(name, v); err != nil {
+				return err
+			}
+		}
+	}
+	part, err := writer.CreateFormFile(field, filename)
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(content); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	encoded := body.Bytes()
+	req, err := http.NewRequest(http.MethodPost, c.apiURL+method, bytes.NewReader(encoded))
+	if err != nil {
+		return err
+	}
+	// Retries resend the body, and token rotation replaces the bearer token.
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(encoded)), nil
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	return doRequest(c.httpClient, req, response)
+}
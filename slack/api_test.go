@@ -0,0 +1,122 @@
+package slack
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"sync"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+// testIdentityResponse is the ok response a testServer answers methods
+// without a handler with, carrying the identity auth.test reports.
+const testIdentityResponse = `{"ok":true,"team_id":"T0TEST","user_id":"U0TEST"}`
+
+// testRequest is a request received by a testServer.
+type testRequest struct {
+	path          string
+	method        string
+	authorization string
+	form          url.Values
+	files         map[string]string
+}
+
+// testServer is a stand-in Slack API server recording every request it
+// receives. Methods with a handler are answered by it, and every other
+// method with testIdentityResponse.
+type testServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []testRequest
+}
+
+// newTestServer starts a testServer answering the methods in handlers,
+// keyed by method name, with their handler.
+func newTestServer(t *testing.T, handlers map[string]http.HandlerFunc) *testServer {
+	t.Helper()
+	s := &testServer{}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := testRequest{
+			path:          r.URL.Path,
+			method:        path.Base(r.URL.Path),
+			authorization: r.Header.Get("Authorization"),
+			files:         map[string]string{},
+		}
+		if err := r.ParseMultipartForm(1 << 20); err == nil {
+			for field, headers := range r.MultipartForm.File {
+				file, err := headers[0].Open()
+				if err != nil {
+					t.Error(err)
+					continue
+				}
+				content, _ := io.ReadAll(file)
+				file.Close()
+				request.files[field] = string(content)
+			}
+		} else if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		request.form = r.Form
+
+		s.mu.Lock()
+		s.requests = append(s.requests, request)
+		s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if handler, ok := handlers[request.method]; ok {
+			handler(w, r)
+			return
+		}
+		fmt.Fprint(w, testIdentityResponse)
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// apiURL returns the URL to call the server's Slack API methods at.
+func (s *testServer) apiURL() string {
+	return s.URL + "/"
+}
+
+// requestsTo returns the requests received for the method, or every request
+// when method is empty, in the order they were received.
+func (s *testServer) requestsTo(method string) []testRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var requests []testRequest
+	for _, request := range s.requests {
+		if method == "" || request.method == method {
+			requests = append(requests, request)
+		}
+	}
+	return requests
+}
+
+// formValues returns the named form value of each request received for the
+// method, or of every request when method is empty.
+func (s *testServer) formValues(method, name string) []string {
+	var values []string
+	for _, request := range s.requestsTo(method) {
+		values = append(values, request.form.Get(name))
+	}
+	return values
+}
+
+// client returns a client calling the server with the token.
+func (s *testServer) client(token string) *slackClient {
+	return &slackClient{
+		Client:     slack.New(token, slack.OptionAPIURL(s.apiURL())),
+		token:      token,
+		httpClient: &http.Client{},
+		apiURL:     s.apiURL(),
+	}
+}
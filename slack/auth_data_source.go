@@ -0,0 +1,140 @@
+package slack
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &authDataSource{}
+	_ datasource.DataSourceWithConfigure = &authDataSource{}
+)
+
+// NewAuthDataSource is a helper function to simplify the provider implementation.
+func NewAuthDataSource() datasource.DataSource {
+	return &authDataSource{}
+}
+
+// authDataSource is the data source implementation. It has no scope checks,
+// as auth.test needs no scope and the identity is the one recorded at
+// configure time.
+type authDataSource struct {
+	clients *slackClients
+}
+
+// authDataSourceModel maps the data source schema data.
+type authDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	TokenType    types.String `tfsdk:"token_type"`
+	URL          types.String `tfsdk:"url"`
+	Team         types.String `tfsdk:"team"`
+	TeamID       types.String `tfsdk:"team_id"`
+	User         types.String `tfsdk:"user"`
+	UserID       types.String `tfsdk:"user_id"`
+	BotID        types.String `tfsdk:"bot_id"`
+	EnterpriseID types.String `tfsdk:"enterprise_id"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *authDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.clients = req.ProviderData.(*slackClients)
+}
+
+// Metadata returns the data source type name.
+func (d *authDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth"
+}
+
+// Schema defines the schema for the data source.
+func (d *authDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch the identity one of the provider's tokens authenticates as, as reported by auth.test.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier for this data source. Same as user_id.",
+				Computed:    true,
+			},
+			"token_type": schema.StringAttribute{
+				Description: "The provider token to report on: bot, user or admin. Defaults to bot.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(tokenTypeBot), string(tokenTypeUser), string(tokenTypeAdmin)),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL of the workspace.",
+				Computed:    true,
+			},
+			"team": schema.StringAttribute{
+				Description: "The name of the workspace.",
+				Computed:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Description: "Identifier for the workspace.",
+				Computed:    true,
+			},
+			"user": schema.StringAttribute{
+				Description: "The name of the authenticated user.",
+				Computed:    true,
+			},
+			"user_id": schema.StringAttribute{
+				Description: "Identifier for the authenticated user.",
+				Computed:    true,
+			},
+			"bot_id": schema.StringAttribute{
+				Description: "Identifier for the bot, when authenticated with a bot token.",
+				Computed:    true,
+			},
+			"enterprise_id": schema.StringAttribute{
+				Description: "Identifier for the Enterprise Grid organization, if any.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *authDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read auth data source")
+	var state authDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requested := tokenTypeBot
+	if !state.TokenType.IsNull() {
+		requested = tokenType(state.TokenType.ValueString())
+	}
+
+	client := d.clients.client(requested, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map the identity recorded at configure time to the model
+	state.ID = types.StringValue(client.UserID)
+	state.URL = types.StringValue(client.URL)
+	state.Team = types.StringValue(client.Team)
+	state.TeamID = types.StringValue(client.TeamID)
+	state.User = types.StringValue(client.User)
+	state.UserID = types.StringValue(client.UserID)
+	state.BotID = types.StringValue(client.BotID)
+	state.EnterpriseID = types.StringValue(client.EnterpriseID)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Read auth data source", map[string]any{"success": true})
+}
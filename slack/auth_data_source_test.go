@@ -0,0 +1,28 @@
+package slack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAuthDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "slack_auth" "test" {}
+
+data "slack_user" "test" {
+	id = data.slack_auth.test.user_id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.slack_auth.test", "team_id"),
+					resource.TestCheckResourceAttrPair("data.slack_auth.test", "user", "data.slack_user.test", "user.name"),
+				),
+			},
+		},
+	})
+}
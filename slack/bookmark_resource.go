@@ -0,0 +1,286 @@
+package slack
+
+import (
+	"context"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &bookmarkResource{}
+	_ resource.ResourceWithConfigure   = &bookmarkResource{}
+	_ resource.ResourceWithImportState = &bookmarkResource{}
+	_ resource.ResourceWithModifyPlan  = &bookmarkResource{}
+)
+
+// NewBookmarkResource is a helper function to simplify the provider implementation.
+func NewBookmarkResource() resource.Resource {
+	return &bookmarkResource{}
+}
+
+// bookmarkResource is the resource implementation.
+type bookmarkResource struct {
+	client *slackClient
+}
+
+// bookmarkResourceModel maps the resource schema data.
+type bookmarkResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ChannelID types.String `tfsdk:"channel_id"`
+	Title     types.String `tfsdk:"title"`
+	Link      types.String `tfsdk:"link"`
+	Emoji     types.String `tfsdk:"emoji"`
+	Type      types.String `tfsdk:"type"`
+}
+
+// setComputed copies the values read from Slack into the resource model.
+func (m *bookmarkResourceModel) setComputed(bookmark slack.Bookmark) {
+	m.ID = types.StringValue(bookmark.ID)
+	m.ChannelID = types.StringValue(bookmark.ChannelID)
+	m.Title = types.StringValue(bookmark.Title)
+	m.Link = types.StringValue(bookmark.Link)
+	m.Type = types.StringValue(bookmark.Type)
+
+	// An unset emoji is read back as empty.
+	m.Emoji = types.StringNull()
+	if bookmark.Emoji != "" {
+		m.Emoji = types.StringValue(bookmark.Emoji)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *bookmarkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*slackClients).client(tokenTypeBot, &resp.Diagnostics)
+}
+
+// Metadata returns the resource type name.
+func (r *bookmarkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bookmark"
+}
+
+// Schema defines the schema for the resource.
+func (r *bookmarkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a bookmark in a conversation's header. Changes made outside of Terraform are reverted on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier for this bookmark.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the conversation to bookmark in. Changing this forces a new bookmark.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the bookmark.",
+				Required:    true,
+			},
+			"link": schema.StringAttribute{
+				Description: "The URL the bookmark links to.",
+				Required:    true,
+			},
+			"emoji": schema.StringAttribute{
+				Description: "The emoji shown next to the bookmark, such as :chart_with_upwards_trend:. Defaults to the linked site's icon.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the bookmark. Slack currently only supports link. Changing this forces a new bookmark. Defaults to link.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("link"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("link"),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan fails the plan early when the token lacks the scopes needed to
+// manage bookmarks.
+func (r *bookmarkResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkScopes(
+		requireScope(path.Empty(), "bookmarks:read"),
+		requireScope(path.Empty(), "bookmarks:write"),
+	)...)
+}
+
+// Create adds the bookmark and sets the initial Terraform state.
+func (r *bookmarkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create bookmark resource")
+	var plan bookmarkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bookmark, err := r.client.AddBookmark(plan.ChannelID.ValueString(), slack.AddBookmarkParameters{
+		Title: plan.Title.ValueString(),
+		Type:  plan.Type.ValueString(),
+		Link:  plan.Link.ValueString(),
+		Emoji: plan.Emoji.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Bookmark",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(bookmark.ID)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Created bookmark resource", map[string]any{"success": true, "id": bookmark.ID})
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *bookmarkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read bookmark resource")
+	var state bookmarkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bookmarks, err := r.client.ListBookmarks(state.ChannelID.ValueString())
+	if isSlackError(err, "channel_not_found") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Bookmarks",
+			err.Error(),
+		)
+		return
+	}
+
+	bookmark := findBookmark(bookmarks, state.ID.ValueString())
+	if bookmark == nil {
+		tflog.Info(ctx, "Bookmark no longer exists, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.setComputed(*bookmark)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Read bookmark resource", map[string]any{"success": true})
+}
+
+// findBookmark returns the bookmark with the given ID, or nil.
+func findBookmark(bookmarks []slack.Bookmark, id string) *slack.Bookmark {
+	for i := range bookmarks {
+		if bookmarks[i].ID == id {
+			return &bookmarks[i]
+		}
+	}
+	return nil
+}
+
+// Update edits the bookmark and sets the updated Terraform state on success.
+func (r *bookmarkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update bookmark resource")
+	var plan, state bookmarkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An empty emoji clears the one set before.
+	title := plan.Title.ValueString()
+	emoji := plan.Emoji.ValueString()
+	_, err := r.client.EditBookmark(state.ChannelID.ValueString(), state.ID.ValueString(), slack.EditBookmarkParameters{
+		Title: &title,
+		Emoji: &emoji,
+		Link:  plan.Link.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Bookmark",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Updated bookmark resource", map[string]any{"success": true})
+}
+
+// Delete removes the bookmark and the Terraform state on success.
+func (r *bookmarkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete bookmark resource")
+	var state bookmarkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveBookmark(state.ChannelID.ValueString(), state.ID.ValueString())
+	if err != nil && !isSlackError(err, "not_found", "channel_not_found") {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Bookmark",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted bookmark resource", map[string]any{"success": true})
+}
+
+// ImportState imports an existing bookmark by its channel ID and bookmark ID,
+// in the form channel_id/bookmark_id.
+func (r *bookmarkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	channelID, bookmarkID, err := parseImportID(req.ID, "channel_id", "bookmark_id")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), bookmarkID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), channelID)...)
+}
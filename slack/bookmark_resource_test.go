@@ -0,0 +1,88 @@
+package slack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBookmarkResourceModelSetComputed(t *testing.T) {
+	var model bookmarkResourceModel
+	model.setComputed(slack.Bookmark{ID: "Bk1", ChannelID: "C1", Title: "Dashboard", Link: "https://example.com", Type: "link"})
+
+	if model.ID.ValueString() != "Bk1" || model.Title.ValueString() != "Dashboard" || model.Type.ValueString() != "link" {
+		t.Errorf("expected the bookmark to be mapped, got %+v", model)
+	}
+	if !model.Emoji.IsNull() {
+		t.Errorf("expected an unset emoji to be null, got %q", model.Emoji.ValueString())
+	}
+
+	if bookmark := findBookmark([]slack.Bookmark{{ID: "Bk0"}, {ID: "Bk1"}}, "Bk1"); bookmark == nil || bookmark.ID != "Bk1" {
+		t.Errorf("expected bookmark Bk1 to be found, got %v", bookmark)
+	}
+	if bookmark := findBookmark([]slack.Bookmark{{ID: "Bk0"}}, "Bk1"); bookmark != nil {
+		t.Errorf("expected no bookmark to be found, got %v", bookmark)
+	}
+}
+
+func TestAccBookmarkResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "slack_bookmark" "test" {
+	channel_id = "%s"
+	title      = "Created by Terraform"
+	link       = "https://www.terraform.io/"
+}
+`, slackTestConversationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("slack_bookmark.test", "id"),
+					resource.TestCheckResourceAttr("slack_bookmark.test", "title", "Created by Terraform"),
+					resource.TestCheckResourceAttr("slack_bookmark.test", "type", "link"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "slack_bookmark.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccBookmarkImportID("slack_bookmark.test"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "slack_bookmark" "test" {
+	channel_id = "%s"
+	title      = "Updated by Terraform"
+	link       = "https://registry.terraform.io/"
+	emoji      = ":books:"
+}
+`, slackTestConversationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_bookmark.test", "title", "Updated by Terraform"),
+					resource.TestCheckResourceAttr("slack_bookmark.test", "link", "https://registry.terraform.io/"),
+					resource.TestCheckResourceAttr("slack_bookmark.test", "emoji", ":books:"),
+				),
+			},
+		},
+	})
+}
+
+// testAccBookmarkImportID returns the channel_id/bookmark_id import
+// identifier of the named bookmark resource.
+func testAccBookmarkImportID(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", name)
+		}
+		return rs.Primary.Attributes["channel_id"] + "/" + rs.Primary.ID, nil
+	}
+}
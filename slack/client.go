@@ -0,0 +1,121 @@
+package slack
+
+import (
+	"strings"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// slackClient is the provider-level client handed to data sources and
+// resources. It embeds the Slack API client and records who the configured
+// token authenticates as.
+type slackClient struct {
+	*slack.Client
+
+	// Identity reported by auth.test when the provider was configured.
+	URL          string
+	Team         string
+	TeamID       string
+	User         string
+	UserID       string
+	BotID        string
+	EnterpriseID string
+
+	// scopes records the OAuth scopes granted to the token, as reported by
+	// the latest response, so that scopes granted to a refreshed token are
+	// seen.
+	scopes *scopeRecorder
+
+	// cache serves user and conversation lookups when the provider's
+	// read_cache is enabled, and is nil otherwise.
+	cache *readCache
+
+	// teamProfile holds the workspace's custom profile fields, read from
+	// team.profile.get at most once.
+	teamProfile teamProfileCache
+
+	// token, httpClient and apiURL are what the embedded client calls the
+	// API with, for methods it does not implement.
+	token      string
+	httpClient httpDoer
+	apiURL     string
+}
+
+// newSlackClient creates a Slack API client for the token sending requests
+// through httpClient to apiURL, verifies the token with auth.test and returns
+// a client carrying the authenticated identity and granted scopes.
+func newSlackClient(token string, httpClient httpDoer, apiURL string, options ...slack.Option) (*slackClient, error) {
+	recorder := &scopeRecorder{client: httpClient}
+	api := slack.New(token, append([]slack.Option{slack.OptionHTTPClient(recorder), slack.OptionAPIURL(apiURL)}, options...)...)
+
+	identity, err := api.AuthTest()
+	if err != nil {
+		return nil, err
+	}
+
+	return &slackClient{
+		Client:       api,
+		URL:          identity.URL,
+		Team:         identity.Team,
+		TeamID:       identity.TeamID,
+		User:         identity.User,
+		UserID:       identity.UserID,
+		BotID:        identity.BotID,
+		EnterpriseID: identity.EnterpriseID,
+		scopes:       recorder,
+		token:        token,
+		httpClient:   recorder,
+		apiURL:       apiURL,
+	}, nil
+}
+
+// tokenType is the kind of Slack token a data source or resource calls the
+// API with.
+type tokenType string
+
+const (
+	tokenTypeBot   tokenType = "bot"
+	tokenTypeUser  tokenType = "user"
+	tokenTypeAdmin tokenType = "admin"
+)
+
+// slackClients is the provider data handed to data sources and resources,
+// holding a client for each configured token type. Token types sharing a
+// token share a client.
+type slackClients struct {
+	bot   *slackClient
+	user  *slackClient
+	admin *slackClient
+
+	// httpClient and apiURL are what the clients send requests through,
+	// for calls that do not need a token.
+	httpClient httpDoer
+	apiURL     string
+}
+
+// client returns the client for the token type, or adds an error naming the
+// provider attribute to set when that token type is not configured.
+func (c *slackClients) client(tokenType tokenType, diags *diag.Diagnostics) *slackClient {
+	var client *slackClient
+	switch tokenType {
+	case tokenTypeBot:
+		client = c.bot
+	case tokenTypeUser:
+		client = c.user
+	case tokenTypeAdmin:
+		client = c.admin
+	}
+
+	if client == nil {
+		name := string(tokenType)
+		diags.AddError(
+			"Missing Slack API "+strings.ToUpper(name[:1])+name[1:]+" Token",
+			"This data source or resource calls Slack API methods that require a "+name+" token, but none is configured. "+
+				"Set "+name+"_token or token in the provider configuration, or use the SLACK_"+strings.ToUpper(name)+
+				"_TOKEN or SLACK_TOKEN environment variable.",
+		)
+	}
+	return client
+}
@@ -0,0 +1,42 @@
+package slack
+
+import (
+	"context"
+	"time"
+)
+
+// clock abstracts the passage of time so that retries and throttling can be
+// tested without waiting.
+type clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// Sleep waits for d, returning early with the context's error if it is
+	// cancelled.
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+// realClock is the clock backed by the time package.
+type realClock struct{}
+
+// Now returns the current time.
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// Sleep waits for d, returning early with the context's error if it is
+// cancelled.
+func (realClock) Sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,50 @@
+package slack
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// fakeClock is a clock whose Sleep returns immediately, recording the
+// requested wait and advancing the current time by it.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+// newFakeClock returns a fake clock starting at an arbitrary fixed time.
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+// Now returns the fake current time.
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Sleep records d and advances the fake current time by it.
+func (c *fakeClock) Sleep(_ context.Context, d time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	return nil
+}
+
+// Advance moves the fake current time forward without recording a sleep.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Sleeps returns the waits recorded so far.
+func (c *fakeClock) Sleeps() []time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]time.Duration{}, c.sleeps...)
+}
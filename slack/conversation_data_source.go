@@ -2,19 +2,24 @@ package slack
 
 import (
 	"context"
+	"strings"
 
 	"github.com/slack-go/slack"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &conversationDataSource{}
-	_ datasource.DataSourceWithConfigure = &conversationDataSource{}
+	_ datasource.DataSource                     = &conversationDataSource{}
+	_ datasource.DataSourceWithConfigure        = &conversationDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &conversationDataSource{}
 )
 
 // NewConversationDataSource is a helper function to simplify the provider implementation.
@@ -24,12 +29,72 @@ func NewConversationDataSource() datasource.DataSource {
 
 // conversationDataSource is the data source implementation.
 type conversationDataSource struct {
-	client *slack.Client
+	client *slackClient
 }
 
 // conversationDataSourceModel maps the data source schema data.
 type conversationDataSourceModel struct {
-	Conversation conversationModel `tfsdk:"channels"`
+	ID                 types.String  `tfsdk:"id"`
+	IncludeArchived    types.Bool    `tfsdk:"include_archived"`
+	Created            types.String  `tfsdk:"created"`
+	Creator            types.String  `tfsdk:"creator"`
+	IsArchived         types.Bool    `tfsdk:"is_archived"`
+	IsChannel          types.Bool    `tfsdk:"is_channel"`
+	IsExtShared        types.Bool    `tfsdk:"is_ext_shared"`
+	IsGeneral          types.Bool    `tfsdk:"is_general"`
+	IsGroup            types.Bool    `tfsdk:"is_group"`
+	IsIM               types.Bool    `tfsdk:"is_im"`
+	IsMember           types.Bool    `tfsdk:"is_member"`
+	IsOpen             types.Bool    `tfsdk:"is_open"`
+	IsOrgShared        types.Bool    `tfsdk:"is_org_shared"`
+	IsPendingExtShared types.Bool    `tfsdk:"is_pending_ext_shared"`
+	IsPrivate          types.Bool    `tfsdk:"is_private"`
+	IsShared           types.Bool    `tfsdk:"is_shared"`
+	LastRead           types.String  `tfsdk:"last_read"`
+	Latest             *latestModel  `tfsdk:"latest"`
+	Locale             types.String  `tfsdk:"locale"`
+	Name               types.String  `tfsdk:"name"`
+	NameNormalized     types.String  `tfsdk:"name_normalized"`
+	NumMembers         types.Int64   `tfsdk:"num_members"`
+	Priority           types.Int64   `tfsdk:"priority"`
+	Purpose            *purposeModel `tfsdk:"purpose"`
+	Topic              *topicModel   `tfsdk:"topic"`
+	Unlinked           types.Int64   `tfsdk:"unlinked"`
+	UnreadCount        types.Int64   `tfsdk:"unread_count"`
+	UnreadCountDisplay types.Int64   `tfsdk:"unread_count_display"`
+	User               types.String  `tfsdk:"user"`
+}
+
+// setComputed copies the values read from Slack into the data source model.
+func (m *conversationDataSourceModel) setComputed(conversation conversationModel) {
+	m.ID = conversation.ID
+	m.Created = conversation.Created
+	m.Creator = conversation.Creator
+	m.IsArchived = conversation.IsArchived
+	m.IsChannel = conversation.IsChannel
+	m.IsExtShared = conversation.IsExtShared
+	m.IsGeneral = conversation.IsGeneral
+	m.IsGroup = conversation.IsGroup
+	m.IsIM = conversation.IsIM
+	m.IsMember = conversation.IsMember
+	m.IsOpen = conversation.IsOpen
+	m.IsOrgShared = conversation.IsOrgShared
+	m.IsPendingExtShared = conversation.IsPendingExtShared
+	m.IsPrivate = conversation.IsPrivate
+	m.IsShared = conversation.IsShared
+	m.LastRead = conversation.LastRead
+	m.Latest = conversation.Latest
+	m.Locale = conversation.Locale
+	m.Name = conversation.Name
+	m.NameNormalized = conversation.NameNormalized
+	m.NumMembers = conversation.NumMembers
+	m.Priority = conversation.Priority
+	m.Purpose = conversation.Purpose
+	m.Topic = conversation.Topic
+	m.Unlinked = conversation.Unlinked
+	m.UnreadCount = conversation.UnreadCount
+	m.UnreadCountDisplay = conversation.UnreadCountDisplay
+	m.User = conversation.User
 }
 
 type conversationModel struct {
@@ -83,12 +148,12 @@ type latestModel struct {
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *conversationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *conversationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*slack.Client)
+	d.client = req.ProviderData.(*slackClients).client(tokenTypeBot, &resp.Diagnostics)
 
 }
 
@@ -97,167 +162,199 @@ func (d *conversationDataSource) Metadata(_ context.Context, req datasource.Meta
 	resp.TypeName = req.ProviderTypeName + "_conversation"
 }
 
+// ConfigValidators ensures that exactly one lookup key is configured.
+func (d *conversationDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 // Schema defines the schema for the data source.
 func (d *conversationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := conversationAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "Identifier for this conversation. Conflicts with name.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "The name of the conversation, without the leading #. Public and private channels are searched. Conflicts with id.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["include_archived"] = schema.BoolAttribute{
+		Description: "Whether archived conversations are considered when looking up by name. Defaults to false.",
+		Optional:    true,
+	}
+
 	resp.Schema = schema.Schema{
-		Description: "Fetch a conversation.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Identifier for this conversation.",
-				Required:    true,
-			},
-			"created": schema.StringAttribute{
-				Description: "A Unix timestamp indicating when the conversation was created.",
-				Computed:    true,
-			},
-			"creator": schema.StringAttribute{
-				Description: "The ID for the user that created the conversation.",
-				Computed:    true,
-			},
-			"is_archived": schema.BoolAttribute{
-				Description: "Indicates whether the conversation is archived.",
-				Computed:    true,
-			},
-			"is_channel": schema.BoolAttribute{
-				Description: "Indicates whether the conversation is a channel.",
-				Computed:    true,
-			},
-			"is_ext_shared": schema.BoolAttribute{
-				Description: "Indicates whether the conversation is externally shared.",
-				Computed:    true,
-			},
-			"is_general": schema.BoolAttribute{
-				Description: "Indicates whether the conversation is general.",
-				Computed:    true,
-			},
-			"is_group": schema.BoolAttribute{
-				Description: "Indicates whether the conversation is a group.",
-				Computed:    true,
-			},
-			"is_im": schema.BoolAttribute{
-				Description: "Indicates whether the conversation is an IM.",
-				Computed:    true,
-			},
-			"is_member": schema.BoolAttribute{
-				Description: "Indicates whether the conversation is a member.",
-				Computed:    true,
-			},
-			"is_open": schema.BoolAttribute{
-				Description: "Indicates whether the conversation is open.",
-				Computed:    true,
-			},
-			"is_org_shared": schema.BoolAttribute{
-				Description: "Indicates whether the conversation is org shared.",
-				Computed:    true,
-			},
-			"is_pending_ext_shared": schema.BoolAttribute{
-				Description: "Indicates whether the conversation is a pending external share.",
-				Computed:    true,
-			},
-			"is_private": schema.BoolAttribute{
-				Description: "Indicates whether the conversation is private.",
-				Computed:    true,
-			},
-			"is_shared": schema.BoolAttribute{
-				Description: "Indicates whether the conversation is shared.",
-				Computed:    true,
-			},
-			"last_read": schema.StringAttribute{
-				Description: "The last time the conversation was read.",
-				Computed:    true,
-			},
-			"locale": schema.StringAttribute{
-				Description: "The locale set for the conversation.",
-				Computed:    true,
-			},
-			"name": schema.StringAttribute{
-				Description: "The name of the conversation.",
-				Computed:    true,
-			},
-			"name_normalized": schema.StringAttribute{
-				Description: "The name field, but with any non-Latin characters filtered out.",
-				Computed:    true,
-			},
-			"num_members": schema.Int64Attribute{
-				Description: "The number of members in the conversation.",
-				Computed:    true,
-			},
-			"priority": schema.Int64Attribute{
-				Description: "The conversation's priority value.",
-				Computed:    true,
-			},
-			"unlinked": schema.Int64Attribute{
-				Description: "Conversation unlinked value.",
-				Computed:    true,
-			},
-			"unread_count": schema.Int64Attribute{
-				Description: "The count of unread messages in the conversation.",
-				Computed:    true,
-			},
-			"unread_count_display": schema.Int64Attribute{
-				Description: "The unread count display value.",
-				Computed:    true,
-			},
-			"user": schema.StringAttribute{
-				Description: "The ID for the user who started the conversation.",
-				Computed:    true,
-			},
-			"purpose": schema.SingleNestedAttribute{
-				Description: "The conversation's purpose object.",
-				Computed:    true,
-				Attributes: map[string]schema.Attribute{
-					"creator": schema.StringAttribute{
-						Description: "The ID for the creator of the purpose.",
-						Computed:    true,
-					},
-					"last_set": schema.Int64Attribute{
-						Description: "A Unix timestamp indicating when the purpose was last set.",
-						Computed:    true,
-					},
-					"value": schema.StringAttribute{
-						Description: "The conversation's purpose.",
-						Computed:    true,
-					},
+		Description: "Fetch a conversation by ID or name.",
+		Attributes:  attributes,
+	}
+}
+
+// conversationAttributes returns the computed attributes of a conversation,
+// shared by the slack_conversation and slack_conversations data sources.
+func conversationAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Identifier for this conversation.",
+			Computed:    true,
+		},
+		"created": schema.StringAttribute{
+			Description: "A Unix timestamp indicating when the conversation was created.",
+			Computed:    true,
+		},
+		"creator": schema.StringAttribute{
+			Description: "The ID for the user that created the conversation.",
+			Computed:    true,
+		},
+		"is_archived": schema.BoolAttribute{
+			Description: "Indicates whether the conversation is archived.",
+			Computed:    true,
+		},
+		"is_channel": schema.BoolAttribute{
+			Description: "Indicates whether the conversation is a channel.",
+			Computed:    true,
+		},
+		"is_ext_shared": schema.BoolAttribute{
+			Description: "Indicates whether the conversation is externally shared.",
+			Computed:    true,
+		},
+		"is_general": schema.BoolAttribute{
+			Description: "Indicates whether the conversation is general.",
+			Computed:    true,
+		},
+		"is_group": schema.BoolAttribute{
+			Description: "Indicates whether the conversation is a group.",
+			Computed:    true,
+		},
+		"is_im": schema.BoolAttribute{
+			Description: "Indicates whether the conversation is an IM.",
+			Computed:    true,
+		},
+		"is_member": schema.BoolAttribute{
+			Description: "Indicates whether the conversation is a member.",
+			Computed:    true,
+		},
+		"is_open": schema.BoolAttribute{
+			Description: "Indicates whether the conversation is open.",
+			Computed:    true,
+		},
+		"is_org_shared": schema.BoolAttribute{
+			Description: "Indicates whether the conversation is org shared.",
+			Computed:    true,
+		},
+		"is_pending_ext_shared": schema.BoolAttribute{
+			Description: "Indicates whether the conversation is a pending external share.",
+			Computed:    true,
+		},
+		"is_private": schema.BoolAttribute{
+			Description: "Indicates whether the conversation is private.",
+			Computed:    true,
+		},
+		"is_shared": schema.BoolAttribute{
+			Description: "Indicates whether the conversation is shared.",
+			Computed:    true,
+		},
+		"last_read": schema.StringAttribute{
+			Description: "The last time the conversation was read.",
+			Computed:    true,
+		},
+		"locale": schema.StringAttribute{
+			Description: "The locale set for the conversation.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the conversation.",
+			Computed:    true,
+		},
+		"name_normalized": schema.StringAttribute{
+			Description: "The name field, but with any non-Latin characters filtered out.",
+			Computed:    true,
+		},
+		"num_members": schema.Int64Attribute{
+			Description: "The number of members in the conversation.",
+			Computed:    true,
+		},
+		"priority": schema.Int64Attribute{
+			Description: "The conversation's priority value.",
+			Computed:    true,
+		},
+		"unlinked": schema.Int64Attribute{
+			Description: "Conversation unlinked value.",
+			Computed:    true,
+		},
+		"unread_count": schema.Int64Attribute{
+			Description: "The count of unread messages in the conversation.",
+			Computed:    true,
+		},
+		"unread_count_display": schema.Int64Attribute{
+			Description: "The unread count display value.",
+			Computed:    true,
+		},
+		"user": schema.StringAttribute{
+			Description: "The ID for the user who started the conversation.",
+			Computed:    true,
+		},
+		"purpose": schema.SingleNestedAttribute{
+			Description: "The conversation's purpose object.",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"creator": schema.StringAttribute{
+					Description: "The ID for the creator of the purpose.",
+					Computed:    true,
+				},
+				"last_set": schema.Int64Attribute{
+					Description: "A Unix timestamp indicating when the purpose was last set.",
+					Computed:    true,
+				},
+				"value": schema.StringAttribute{
+					Description: "The conversation's purpose.",
+					Computed:    true,
 				},
 			},
-			"topic": schema.SingleNestedAttribute{
-				Description: "The conversation's topic object.",
-				Computed:    true,
-				Attributes: map[string]schema.Attribute{
-					"creator": schema.StringAttribute{
-						Description: "The ID for the creator of the topic.",
-						Computed:    true,
-					},
-					"last_set": schema.Int64Attribute{
-						Description: "A Unix timestamp indicating when the topic was last set.",
-						Computed:    true,
-					},
-					"value": schema.StringAttribute{
-						Description: "The conversation's topic.",
-						Computed:    true,
-					},
+		},
+		"topic": schema.SingleNestedAttribute{
+			Description: "The conversation's topic object.",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"creator": schema.StringAttribute{
+					Description: "The ID for the creator of the topic.",
+					Computed:    true,
+				},
+				"last_set": schema.Int64Attribute{
+					Description: "A Unix timestamp indicating when the topic was last set.",
+					Computed:    true,
+				},
+				"value": schema.StringAttribute{
+					Description: "The conversation's topic.",
+					Computed:    true,
 				},
 			},
-			"latest": schema.SingleNestedAttribute{
-				Description: "The latest post in the conversation.",
-				Computed:    true,
-				Attributes: map[string]schema.Attribute{
-					"type": schema.StringAttribute{
-						Description: "The type of post.",
-						Computed:    true,
-					},
-					"user": schema.StringAttribute{
-						Description: "The ID of the user that made the post.",
-						Computed:    true,
-					},
-					"text": schema.StringAttribute{
-						Description: "The text of the post.",
-						Computed:    true,
-					},
-					"ts": schema.StringAttribute{
-						Description: "A Unix timestamp for the post.",
-						Computed:    true,
-					},
+		},
+		"latest": schema.SingleNestedAttribute{
+			Description: "The latest post in the conversation.",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description: "The type of post.",
+					Computed:    true,
+				},
+				"user": schema.StringAttribute{
+					Description: "The ID of the user that made the post.",
+					Computed:    true,
+				},
+				"text": schema.StringAttribute{
+					Description: "The text of the post.",
+					Computed:    true,
+				},
+				"ts": schema.StringAttribute{
+					Description: "A Unix timestamp for the post.",
+					Computed:    true,
 				},
 			},
 		},
@@ -267,17 +364,36 @@ func (d *conversationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 // Read refreshes the Terraform state with the latest data.
 func (d *conversationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	tflog.Debug(ctx, "Preparing to read conversation data source")
-	var state conversationModel
+	var state conversationDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Name.IsNull() {
+		// Name lookups list both public and private channels.
+		resp.Diagnostics.Append(d.client.checkScopes(
+			requireScope(path.Root("name"), "channels:read"),
+			requireScope(path.Root("name"), "groups:read"),
+		)...)
+	} else {
+		resp.Diagnostics.Append(d.client.checkScopes(
+			requireScope(path.Root("id"), "channels:read", "groups:read", "im:read", "mpim:read"),
+		)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	conversation := slack.GetConversationInfoInput{
-		ChannelID:         state.ID.ValueString(),
-		IncludeLocale:     true,
-		IncludeNumMembers: true,
+	if !state.Name.IsNull() {
+		state.ID = d.findConversationIDByName(state.Name.ValueString(), state.IncludeArchived.ValueBool(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	conversationResponse, err := d.client.GetConversationInfo(&conversation)
+	conversationResponse, err := d.client.getConversation(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Conversation",
@@ -286,6 +402,52 @@ func (d *conversationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	// Map response body to model
+	state.setComputed(newConversationModel(conversationResponse))
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Read conversation data source", map[string]any{"success": true})
+}
+
+// findConversationIDByName pages through public and private conversations
+// for the one with the given name. When there is none, the diagnostic lists
+// similarly named conversations.
+func (d *conversationDataSource) findConversationIDByName(name string, includeArchived bool, diags *diag.Diagnostics) types.String {
+	conversations, err := d.client.listChannels(!includeArchived)
+	if err != nil {
+		diags.AddError(
+			"Unable to List Conversations",
+			err.Error(),
+		)
+		return types.StringNull()
+	}
+
+	names := make([]string, 0, len(conversations))
+	for _, conversation := range conversations {
+		if conversation.Name == name {
+			return types.StringValue(conversation.ID)
+		}
+		names = append(names, conversation.Name)
+	}
+
+	detail := "No conversation is named " + name + "."
+	if suggestions := nearMatches(name, names, 5); len(suggestions) > 0 {
+		detail += " Did you mean one of: " + strings.Join(suggestions, ", ") + "?"
+	}
+	if !includeArchived {
+		detail += " Set include_archived to also search archived conversations."
+	}
+	diags.AddAttributeError(
+		path.Root("name"),
+		"Conversation Not Found",
+		detail,
+	)
+	return types.StringNull()
+}
+
+// newConversationModel maps a Slack conversation to the shared conversation model.
+func newConversationModel(conversationResponse *slack.Channel) conversationModel {
 	latestData := latestModel{
 		Type: types.StringValue(""),
 		User: types.StringValue(""),
@@ -314,8 +476,7 @@ func (d *conversationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		Value:   types.StringValue(conversationResponse.Purpose.Value),
 	}
 
-	// Map response body to model
-	state = conversationModel{
+	return conversationModel{
 		Created:            types.StringValue(conversationResponse.Created.Time().Format("Mon Jan 2 15:04:05 MST 2006")),
 		Creator:            types.StringValue(conversationResponse.Creator),
 		ID:                 types.StringValue(conversationResponse.ID),
@@ -345,8 +506,4 @@ func (d *conversationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		UnreadCountDisplay: types.Int64Value(int64(conversationResponse.UnreadCountDisplay)),
 		User:               types.StringValue(conversationResponse.User),
 	}
-
-	// Set state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-	tflog.Debug(ctx, "Read conversation data source", map[string]any{"success": true})
 }
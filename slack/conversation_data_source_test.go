@@ -16,10 +16,16 @@ func TestAccConversationDataSource(t *testing.T) {
 data "slack_conversation" "test" {
 	id = "%s"
 }
+
+data "slack_conversation" "by_name" {
+	name             = data.slack_conversation.test.name
+	include_archived = true
+}
 `, slackTestConversationID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify placeholder id attribute
 					resource.TestCheckResourceAttrSet("data.slack_conversation.test", "id"),
+					resource.TestCheckResourceAttrPair("data.slack_conversation.by_name", "id", "data.slack_conversation.test", "id"),
 				),
 			},
 		},
@@ -0,0 +1,308 @@
+package slack
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// conversationsInviteLimit is the maximum number of users conversations.invite accepts per call.
+const conversationsInviteLimit = 1000
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &conversationMembersResource{}
+	_ resource.ResourceWithConfigure   = &conversationMembersResource{}
+	_ resource.ResourceWithImportState = &conversationMembersResource{}
+	_ resource.ResourceWithModifyPlan  = &conversationMembersResource{}
+)
+
+// NewConversationMembersResource is a helper function to simplify the provider implementation.
+func NewConversationMembersResource() resource.Resource {
+	return &conversationMembersResource{}
+}
+
+// conversationMembersResource is the resource implementation.
+type conversationMembersResource struct {
+	client *slackClient
+}
+
+// conversationMembersResourceModel maps the resource schema data.
+type conversationMembersResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ConversationID types.String `tfsdk:"conversation_id"`
+	Members        types.Set    `tfsdk:"members"`
+	Authoritative  types.Bool   `tfsdk:"authoritative"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *conversationMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*slackClients).client(tokenTypeBot, &resp.Diagnostics)
+}
+
+// Metadata returns the resource type name.
+func (r *conversationMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversation_members"
+}
+
+// Schema defines the schema for the resource.
+func (r *conversationMembersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the members of a conversation. In authoritative mode the user the provider " +
+			"authenticates as is left in the conversation even when not listed, since it cannot remove itself. " +
+			"Destroying this resource leaves the conversation's members untouched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier for this resource. Same as conversation_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"conversation_id": schema.StringAttribute{
+				Description: "Identifier for the conversation whose members are managed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The IDs of the users that belong in the conversation.",
+				Required:    true,
+			},
+			"authoritative": schema.BoolAttribute{
+				Description: "When true, members not listed are removed from the conversation. " +
+					"When false, listed members are only ensured to be present. Defaults to true.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// ModifyPlan fails the plan early when the token lacks the scopes needed to
+// list and change the members of a conversation.
+func (r *conversationMembersResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkScopes(
+		requireScope(path.Root("members"), "channels:read", "groups:read", "im:read", "mpim:read"),
+		requireScope(path.Root("members"), "channels:manage", "channels:write", "groups:write", "im:write", "mpim:write"),
+	)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *conversationMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create conversation members resource")
+	var plan conversationMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.ConversationID
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Created conversation members resource", map[string]any{"success": true})
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *conversationMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read conversation members resource")
+	var state conversationMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := getAllConversationMembers(r.client, state.ConversationID.ValueString())
+	if isSlackError(err, "channel_not_found") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Conversation Members",
+			err.Error(),
+		)
+		return
+	}
+
+	var desired []string
+	resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The user the provider authenticates as is only tracked when listed.
+	// In additive mode only the listed members are tracked, so that
+	// anyone removed by hand shows up as drift.
+	members := r.withoutSelf(current, desired)
+	if !state.Authoritative.ValueBool() {
+		members = intersection(desired, current)
+	}
+
+	var diags diag.Diagnostics
+	state.Members, diags = types.SetValueFrom(ctx, types.StringType, members)
+	resp.Diagnostics.Append(diags...)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Read conversation members resource", map[string]any{"success": true})
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *conversationMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update conversation members resource")
+	var plan conversationMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Updated conversation members resource", map[string]any{"success": true})
+}
+
+// Delete removes the Terraform state, leaving the conversation's members untouched.
+func (r *conversationMembersResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleted conversation members resource, leaving the members untouched", map[string]any{"success": true})
+}
+
+// ImportState imports the members of an existing conversation by its ID.
+func (r *conversationMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("conversation_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authoritative"), true)...)
+}
+
+// reconcile invites missing members and, in authoritative mode, removes
+// unexpected ones.
+func (r *conversationMembersResource) reconcile(ctx context.Context, plan *conversationMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conversationID := plan.ConversationID.ValueString()
+
+	var desired []string
+	diags.Append(plan.Members.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := getAllConversationMembers(r.client, conversationID)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Conversation Members",
+			err.Error(),
+		)
+		return diags
+	}
+
+	missing := difference(desired, current)
+	for start := 0; start < len(missing); start += conversationsInviteLimit {
+		end := start + conversationsInviteLimit
+		if end > len(missing) {
+			end = len(missing)
+		}
+
+		tflog.Debug(ctx, "Inviting conversation members", map[string]any{"conversation_id": conversationID, "users": missing[start:end]})
+		if _, err := r.client.InviteUsersToConversation(conversationID, missing[start:end]...); err != nil {
+			diags.AddError(
+				"Unable to Invite Conversation Members",
+				err.Error(),
+			)
+			return diags
+		}
+	}
+
+	if !plan.Authoritative.ValueBool() {
+		return diags
+	}
+
+	for _, member := range difference(r.withoutSelf(current, desired), desired) {
+		tflog.Debug(ctx, "Removing conversation member", map[string]any{"conversation_id": conversationID, "user": member})
+		if err := r.client.KickUserFromConversation(conversationID, member); err != nil && !isSlackError(err, "not_in_channel") {
+			diags.AddError(
+				"Unable to Remove Conversation Member",
+				"Could not remove "+member+": "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// withoutSelf returns the current members without the user the provider
+// authenticates as, unless it is desired, as it cannot remove itself from the
+// conversation.
+func (r *conversationMembersResource) withoutSelf(current, desired []string) []string {
+	self := []string{r.client.UserID}
+	if len(intersection(self, desired)) > 0 {
+		return current
+	}
+	return append([]string{}, difference(current, self)...)
+}
+
+// difference returns the elements of a that are not in b.
+func difference(a, b []string) []string {
+	seen := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		seen[v] = struct{}{}
+	}
+
+	var out []string
+	for _, v := range a {
+		if _, ok := seen[v]; !ok {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// intersection returns the elements of a that are also in b.
+func intersection(a, b []string) []string {
+	seen := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		seen[v] = struct{}{}
+	}
+
+	out := []string{}
+	for _, v := range a {
+		if _, ok := seen[v]; ok {
+			out = append(out, v)
+		}
+	}
+	return out
+}
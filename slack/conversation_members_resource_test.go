@@ -0,0 +1,66 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccConversationMembersResource(t *testing.T) {
+	name := "tf-acc-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "slack_conversation" "test" {
+	name = "%s"
+}
+
+resource "slack_conversation_members" "test" {
+	conversation_id = slack_conversation.test.id
+	authoritative   = false
+	members         = ["%s"]
+}
+`, name, slackTestUserID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("slack_conversation_members.test", "id", "slack_conversation.test", "id"),
+					resource.TestCheckResourceAttr("slack_conversation_members.test", "members.#", "1"),
+					resource.TestCheckTypeSetElemAttr("slack_conversation_members.test", "members.*", slackTestUserID),
+				),
+			},
+		},
+	})
+}
+
+func TestConversationMembersReconcileSelf(t *testing.T) {
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"conversations.members": func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"ok":true,"members":["U0TEST","U1","U2"],"response_metadata":{"next_cursor":""}}`)
+		},
+	})
+	client := server.client("xoxb-test")
+	client.UserID = "U0TEST"
+	r := &conversationMembersResource{client: client}
+
+	plan := conversationMembersResourceModel{
+		ConversationID: types.StringValue("C0TEST"),
+		Members:        types.SetValueMust(types.StringType, []attr.Value{types.StringValue("U1")}),
+		Authoritative:  types.BoolValue(true),
+	}
+	if diags := r.reconcile(context.Background(), &plan); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if kicked := server.formValues("conversations.kick", "user"); len(kicked) != 1 || kicked[0] != "U2" {
+		t.Errorf("expected only U2 to be removed, leaving the provider's own user, got %v", kicked)
+	}
+}
@@ -0,0 +1,493 @@
+package slack
+
+import (
+	"context"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &conversationResource{}
+	_ resource.ResourceWithConfigure   = &conversationResource{}
+	_ resource.ResourceWithImportState = &conversationResource{}
+	_ resource.ResourceWithModifyPlan  = &conversationResource{}
+)
+
+// NewConversationResource is a helper function to simplify the provider implementation.
+func NewConversationResource() resource.Resource {
+	return &conversationResource{}
+}
+
+// conversationResource is the resource implementation.
+type conversationResource struct {
+	client *slackClient
+}
+
+// conversationResourceModel maps the resource schema data.
+type conversationResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	IsPrivate          types.Bool   `tfsdk:"is_private"`
+	Topic              types.String `tfsdk:"topic"`
+	Purpose            types.String `tfsdk:"purpose"`
+	UnarchiveOnCreate  types.Bool   `tfsdk:"unarchive_on_create"`
+	Created            types.String `tfsdk:"created"`
+	Creator            types.String `tfsdk:"creator"`
+	IsArchived         types.Bool   `tfsdk:"is_archived"`
+	IsChannel          types.Bool   `tfsdk:"is_channel"`
+	IsExtShared        types.Bool   `tfsdk:"is_ext_shared"`
+	IsGeneral          types.Bool   `tfsdk:"is_general"`
+	IsGroup            types.Bool   `tfsdk:"is_group"`
+	IsMember           types.Bool   `tfsdk:"is_member"`
+	IsOrgShared        types.Bool   `tfsdk:"is_org_shared"`
+	IsPendingExtShared types.Bool   `tfsdk:"is_pending_ext_shared"`
+	IsShared           types.Bool   `tfsdk:"is_shared"`
+	NameNormalized     types.String `tfsdk:"name_normalized"`
+	NumMembers         types.Int64  `tfsdk:"num_members"`
+}
+
+// setComputed copies the values read from Slack into the resource model.
+func (m *conversationResourceModel) setComputed(conversation conversationModel) {
+	m.ID = conversation.ID
+	m.Name = conversation.Name
+	m.IsPrivate = conversation.IsPrivate
+	m.Topic = conversation.Topic.Value
+	m.Purpose = conversation.Purpose.Value
+	m.Created = conversation.Created
+	m.Creator = conversation.Creator
+	m.IsArchived = conversation.IsArchived
+	m.IsChannel = conversation.IsChannel
+	m.IsExtShared = conversation.IsExtShared
+	m.IsGeneral = conversation.IsGeneral
+	m.IsGroup = conversation.IsGroup
+	m.IsMember = conversation.IsMember
+	m.IsOrgShared = conversation.IsOrgShared
+	m.IsPendingExtShared = conversation.IsPendingExtShared
+	m.IsShared = conversation.IsShared
+	m.NameNormalized = conversation.NameNormalized
+	m.NumMembers = conversation.NumMembers
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *conversationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*slackClients).client(tokenTypeBot, &resp.Diagnostics)
+}
+
+// Metadata returns the resource type name.
+func (r *conversationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversation"
+}
+
+// Schema defines the schema for the resource.
+func (r *conversationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a public or private channel.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier for this conversation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the conversation.",
+				Required:    true,
+			},
+			"is_private": schema.BoolAttribute{
+				Description: "Create a private channel instead of a public one. Changing this forces a new conversation. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"topic": schema.StringAttribute{
+				Description: "The conversation's topic. Left untouched when not configured.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"purpose": schema.StringAttribute{
+				Description: "The conversation's purpose. Left untouched when not configured.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"unarchive_on_create": schema.BoolAttribute{
+				Description: "When an archived conversation with the same name already exists, unarchive and adopt it instead of failing. Defaults to false.",
+				Optional:    true,
+			},
+			"created": schema.StringAttribute{
+				Description: "A Unix timestamp indicating when the conversation was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creator": schema.StringAttribute{
+				Description: "The ID for the user that created the conversation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_archived": schema.BoolAttribute{
+				Description: "Indicates whether the conversation is archived.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_channel": schema.BoolAttribute{
+				Description: "Indicates whether the conversation is a channel.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_ext_shared": schema.BoolAttribute{
+				Description: "Indicates whether the conversation is externally shared.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_general": schema.BoolAttribute{
+				Description: "Indicates whether the conversation is general.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_group": schema.BoolAttribute{
+				Description: "Indicates whether the conversation is a group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_member": schema.BoolAttribute{
+				Description: "Indicates whether the conversation is a member.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_org_shared": schema.BoolAttribute{
+				Description: "Indicates whether the conversation is org shared.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_pending_ext_shared": schema.BoolAttribute{
+				Description: "Indicates whether the conversation is a pending external share.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_shared": schema.BoolAttribute{
+				Description: "Indicates whether the conversation is shared.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name_normalized": schema.StringAttribute{
+				Description: "The name field, but with any non-Latin characters filtered out.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"num_members": schema.Int64Attribute{
+				Description: "The number of members in the conversation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan plans a new normalized name on rename, and fails the plan early
+// when the token lacks the scopes needed to manage a conversation of the
+// planned visibility.
+func (r *conversationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var name, stateName types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &stateName)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !name.Equal(stateName) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name_normalized"), types.StringUnknown())...)
+		}
+	}
+
+	// Nothing more to check before the provider is configured.
+	if r.client == nil {
+		return
+	}
+
+	var isPrivate types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("is_private"), &isPrivate)...)
+	if resp.Diagnostics.HasError() || isPrivate.IsUnknown() {
+		return
+	}
+
+	if isPrivate.ValueBool() {
+		resp.Diagnostics.Append(r.client.checkScopes(
+			requireScope(path.Root("is_private"), "groups:read"),
+			requireScope(path.Root("is_private"), "groups:write"),
+		)...)
+		return
+	}
+	resp.Diagnostics.Append(r.client.checkScopes(
+		requireScope(path.Root("is_private"), "channels:read"),
+		requireScope(path.Root("is_private"), "channels:manage", "channels:write"),
+	)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *conversationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create conversation resource")
+	var plan conversationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conversation, err := r.client.CreateConversation(slack.CreateConversationParams{
+		ChannelName: plan.Name.ValueString(),
+		IsPrivate:   plan.IsPrivate.ValueBool(),
+	})
+	if isSlackError(err, "name_taken") && plan.UnarchiveOnCreate.ValueBool() {
+		conversation, err = r.unarchiveByName(plan.Name.ValueString(), plan.IsPrivate.ValueBool())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Conversation",
+			err.Error(),
+		)
+		return
+	}
+
+	if !plan.Topic.IsUnknown() && !plan.Topic.IsNull() && plan.Topic.ValueString() != conversation.Topic.Value {
+		if _, err := r.client.SetTopicOfConversation(conversation.ID, plan.Topic.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Set Conversation Topic",
+				err.Error(),
+			)
+		}
+	}
+
+	if !plan.Purpose.IsUnknown() && !plan.Purpose.IsNull() && plan.Purpose.ValueString() != conversation.Purpose.Value {
+		if _, err := r.client.SetPurposeOfConversation(conversation.ID, plan.Purpose.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Set Conversation Purpose",
+				err.Error(),
+			)
+		}
+	}
+
+	conversation, err = r.client.GetConversationInfo(&slack.GetConversationInfoInput{
+		ChannelID:         conversation.ID,
+		IncludeNumMembers: true,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Conversation",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.setComputed(newConversationModel(conversation))
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Created conversation resource", map[string]any{"success": true})
+}
+
+// unarchiveByName finds an archived conversation with the given name and
+// visibility and unarchives it.
+func (r *conversationResource) unarchiveByName(name string, isPrivate bool) (*slack.Channel, error) {
+	conversations, err := getAllConversations(r.client, []string{"public_channel", "private_channel"}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range conversations {
+		if conversations[i].Name != name || conversations[i].IsPrivate != isPrivate || !conversations[i].IsArchived {
+			continue
+		}
+
+		if err := r.client.UnArchiveConversation(conversations[i].ID); err != nil {
+			return nil, err
+		}
+		return &conversations[i], nil
+	}
+
+	return nil, slack.SlackErrorResponse{Err: "name_taken"}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *conversationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read conversation resource")
+	var state conversationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conversation, err := r.client.GetConversationInfo(&slack.GetConversationInfoInput{
+		ChannelID:         state.ID.ValueString(),
+		IncludeNumMembers: true,
+	})
+	if isSlackError(err, "channel_not_found") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Conversation",
+			err.Error(),
+		)
+		return
+	}
+
+	// An archived conversation is treated as destroyed.
+	if conversation.IsArchived {
+		tflog.Info(ctx, "Conversation is archived, removing from state", map[string]any{"id": conversation.ID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.setComputed(newConversationModel(conversation))
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Read conversation resource", map[string]any{"success": true})
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *conversationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update conversation resource")
+	var plan, state conversationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	if !plan.Name.Equal(state.Name) {
+		if _, err := r.client.RenameConversation(id, plan.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Rename Conversation",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Topic.IsUnknown() && !plan.Topic.IsNull() && !plan.Topic.Equal(state.Topic) {
+		if _, err := r.client.SetTopicOfConversation(id, plan.Topic.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Set Conversation Topic",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Purpose.IsUnknown() && !plan.Purpose.IsNull() && !plan.Purpose.Equal(state.Purpose) {
+		if _, err := r.client.SetPurposeOfConversation(id, plan.Purpose.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Set Conversation Purpose",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	conversation, err := r.client.GetConversationInfo(&slack.GetConversationInfoInput{
+		ChannelID:         id,
+		IncludeNumMembers: true,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Conversation",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.setComputed(newConversationModel(conversation))
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Updated conversation resource", map[string]any{"success": true})
+}
+
+// Delete archives the conversation and removes the Terraform state on success.
+func (r *conversationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete conversation resource")
+	var state conversationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ArchiveConversation(state.ID.ValueString())
+	if err != nil && !isSlackError(err, "already_archived", "channel_not_found") {
+		resp.Diagnostics.AddError(
+			"Unable to Archive Conversation",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted conversation resource", map[string]any{"success": true})
+}
+
+// ImportState imports an existing conversation by its ID.
+func (r *conversationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,96 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccConversationResource(t *testing.T) {
+	name := "tf-acc-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "slack_conversation" "test" {
+	name  = "%s"
+	topic = "Created by Terraform"
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("slack_conversation.test", "id"),
+					resource.TestCheckResourceAttr("slack_conversation.test", "name", name),
+					resource.TestCheckResourceAttr("slack_conversation.test", "is_private", "false"),
+					resource.TestCheckResourceAttr("slack_conversation.test", "topic", "Created by Terraform"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "slack_conversation.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"unarchive_on_create"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "slack_conversation" "test" {
+	name    = "%s-renamed"
+	topic   = "Updated by Terraform"
+	purpose = "Acceptance testing"
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_conversation.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("slack_conversation.test", "topic", "Updated by Terraform"),
+					resource.TestCheckResourceAttr("slack_conversation.test", "purpose", "Acceptance testing"),
+				),
+			},
+		},
+	})
+}
+
+func TestConversationModifyPlanRename(t *testing.T) {
+	ctx := context.Background()
+	r := &conversationResource{}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	object := func(name string) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for attribute, attributeType := range objectType.AttributeTypes {
+			values[attribute] = tftypes.NewValue(attributeType, nil)
+		}
+		values["id"] = tftypes.NewValue(tftypes.String, "C0TEST")
+		values["name"] = tftypes.NewValue(tftypes.String, name)
+		values["name_normalized"] = tftypes.NewValue(tftypes.String, "old")
+		return tftypes.NewValue(objectType, values)
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: object("old")}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: object("new")}
+
+	resp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var nameNormalized types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("name_normalized"), &nameNormalized)...)
+	if !nameNormalized.IsUnknown() {
+		t.Errorf("expected the normalized name to be unknown on rename, got %v", nameNormalized)
+	}
+}
@@ -0,0 +1,313 @@
+package slack
+
+import (
+	"context"
+	"strings"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &conversationTextResource{}
+	_ resource.ResourceWithConfigure   = &conversationTextResource{}
+	_ resource.ResourceWithImportState = &conversationTextResource{}
+	_ resource.ResourceWithModifyPlan  = &conversationTextResource{}
+)
+
+// NewConversationTopicResource is a helper function to simplify the provider implementation.
+func NewConversationTopicResource() resource.Resource {
+	return &conversationTextResource{
+		attribute: "topic",
+		setScopes: []string{"channels:write.topic", "groups:write.topic", "im:write.topic", "mpim:write.topic", "channels:manage", "channels:write", "groups:write", "im:write", "mpim:write"},
+		get: func(conversation conversationModel) *topicModel {
+			return conversation.Topic
+		},
+		set: func(client *slackClient, id, value string) error {
+			_, err := client.SetTopicOfConversation(id, value)
+			return err
+		},
+	}
+}
+
+// NewConversationPurposeResource is a helper function to simplify the provider implementation.
+func NewConversationPurposeResource() resource.Resource {
+	return &conversationTextResource{
+		attribute: "purpose",
+		setScopes: []string{"channels:manage", "channels:write", "groups:write", "im:write", "mpim:write"},
+		get: func(conversation conversationModel) *topicModel {
+			return (*topicModel)(conversation.Purpose)
+		},
+		set: func(client *slackClient, id, value string) error {
+			_, err := client.SetPurposeOfConversation(id, value)
+			return err
+		},
+	}
+}
+
+// conversationTextResource is the resource implementation managing one text
+// attribute of a conversation, such as its topic or purpose.
+type conversationTextResource struct {
+	client *slackClient
+
+	// attribute names the managed text, both as an attribute and in the
+	// resource type name.
+	attribute string
+	// setScopes lists the scopes any of which allows setting the text.
+	setScopes []string
+	// get returns the text of the conversation, with who last set it and when.
+	get func(conversationModel) *topicModel
+	// set sets the text of the conversation.
+	set func(client *slackClient, id, value string) error
+}
+
+// conversationTextResourceModel maps the resource schema data. Text maps the
+// attribute named after the managed text.
+type conversationTextResourceModel struct {
+	ID             types.String
+	ConversationID types.String
+	Text           types.String
+	Creator        types.String
+	LastSet        types.Int64
+}
+
+// attributes maps the model's fields by attribute name.
+func (r *conversationTextResource) attributes(m *conversationTextResourceModel) map[string]any {
+	return map[string]any{
+		"id":              &m.ID,
+		"conversation_id": &m.ConversationID,
+		r.attribute:       &m.Text,
+		"creator":         &m.Creator,
+		"last_set":        &m.LastSet,
+	}
+}
+
+// getModel reads the model from a plan or state.
+func (r *conversationTextResource) getModel(ctx context.Context, data interface {
+	GetAttribute(context.Context, path.Path, any) diag.Diagnostics
+}) (conversationTextResourceModel, diag.Diagnostics) {
+	var m conversationTextResourceModel
+	var diags diag.Diagnostics
+	for name, target := range r.attributes(&m) {
+		diags.Append(data.GetAttribute(ctx, path.Root(name), target)...)
+	}
+	return m, diags
+}
+
+// setState writes the model to the state.
+func (r *conversationTextResource) setState(ctx context.Context, state *tfsdk.State, m conversationTextResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for name, value := range r.attributes(&m) {
+		diags.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	return diags
+}
+
+// setComputed copies the values read from Slack into the resource model.
+func (r *conversationTextResource) setComputed(m *conversationTextResourceModel, conversation conversationModel) {
+	text := r.get(conversation)
+	m.ID = conversation.ID
+	m.ConversationID = conversation.ID
+	m.Text = text.Value
+	m.Creator = text.Creator
+	m.LastSet = text.LastSet
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *conversationTextResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*slackClients).client(tokenTypeBot, &resp.Diagnostics)
+}
+
+// Metadata returns the resource type name.
+func (r *conversationTextResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversation_" + r.attribute
+}
+
+// Schema defines the schema for the resource.
+func (r *conversationTextResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the " + r.attribute + " of an existing conversation, such as one not managed by slack_conversation. " +
+			"Changes made outside of Terraform are reverted on the next apply. Destroying this resource leaves the " + r.attribute + " untouched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier for this conversation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"conversation_id": schema.StringAttribute{
+				Description: "The ID of the conversation. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			r.attribute: schema.StringAttribute{
+				Description: "The conversation's " + r.attribute + ".",
+				Required:    true,
+			},
+			"creator": schema.StringAttribute{
+				Description: "The ID for the user that last set the " + r.attribute + ".",
+				Computed:    true,
+			},
+			"last_set": schema.Int64Attribute{
+				Description: "A Unix timestamp indicating when the " + r.attribute + " was last set.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan fails the plan early when the token lacks the scopes needed to
+// read and set the text.
+func (r *conversationTextResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkScopes(
+		requireScope(path.Root("conversation_id"), "channels:read", "groups:read", "im:read", "mpim:read"),
+		requireScope(path.Root(r.attribute), r.setScopes...),
+	)...)
+}
+
+// Create sets the text and the initial Terraform state.
+func (r *conversationTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create conversation "+r.attribute+" resource")
+
+	plan, diags := r.getModel(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conversation, diags := r.setText(plan.ConversationID.ValueString(), plan.Text.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setComputed(&plan, newConversationModel(conversation))
+
+	// Set state
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, plan)...)
+	tflog.Debug(ctx, "Created conversation "+r.attribute+" resource", map[string]any{"success": true})
+}
+
+// setText sets the conversation's text, unless it already has that text,
+// and returns the conversation as read back from Slack.
+func (r *conversationTextResource) setText(id, text string) (*slack.Channel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	conversation, err := r.client.GetConversationInfo(&slack.GetConversationInfoInput{ChannelID: id})
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Conversation",
+			err.Error(),
+		)
+		return nil, diags
+	}
+	if r.get(newConversationModel(conversation)).Value.ValueString() == text {
+		return conversation, diags
+	}
+
+	if err := r.set(r.client, id, text); err != nil {
+		diags.AddError(
+			"Unable to Set Conversation "+strings.ToUpper(r.attribute[:1])+r.attribute[1:],
+			err.Error(),
+		)
+		return nil, diags
+	}
+
+	conversation, err = r.client.GetConversationInfo(&slack.GetConversationInfoInput{ChannelID: id})
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Conversation",
+			err.Error(),
+		)
+		return nil, diags
+	}
+	return conversation, diags
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *conversationTextResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read conversation "+r.attribute+" resource")
+
+	state, diags := r.getModel(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conversation, err := r.client.GetConversationInfo(&slack.GetConversationInfoInput{
+		ChannelID: state.ConversationID.ValueString(),
+	})
+	if isSlackError(err, "channel_not_found") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Conversation",
+			err.Error(),
+		)
+		return
+	}
+
+	r.setComputed(&state, newConversationModel(conversation))
+
+	// Set state
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, state)...)
+	tflog.Debug(ctx, "Read conversation "+r.attribute+" resource", map[string]any{"success": true})
+}
+
+// Update sets the text and the updated Terraform state on success.
+func (r *conversationTextResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update conversation "+r.attribute+" resource")
+
+	plan, diags := r.getModel(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conversation, diags := r.setText(plan.ConversationID.ValueString(), plan.Text.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setComputed(&plan, newConversationModel(conversation))
+
+	// Set state
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, plan)...)
+	tflog.Debug(ctx, "Updated conversation "+r.attribute+" resource", map[string]any{"success": true})
+}
+
+// Delete removes the Terraform state, leaving the text untouched.
+func (r *conversationTextResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleted conversation "+r.attribute+" resource, leaving the "+r.attribute+" untouched", map[string]any{"success": true})
+}
+
+// ImportState imports the text of an existing conversation by its ID.
+func (r *conversationTextResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("conversation_id"), req.ID)...)
+}
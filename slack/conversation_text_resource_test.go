@@ -0,0 +1,105 @@
+package slack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// testAccConversationTextResource tests the resource managing the named text
+// attribute of a conversation, such as slack_conversation_topic.
+func testAccConversationTextResource(t *testing.T, attribute string) {
+	name := "slack_conversation_" + attribute + ".test"
+	config := func(text string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "slack_conversation_%s" "test" {
+	conversation_id = "%s"
+	%s = "%s"
+}
+`, attribute, slackTestConversationID, attribute, text)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("Set by Terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", slackTestConversationID),
+					resource.TestCheckResourceAttr(name, attribute, "Set by Terraform"),
+					resource.TestCheckResourceAttrSet(name, "creator"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: config("Updated by Terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, attribute, "Updated by Terraform"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConversationTopicResource(t *testing.T) {
+	testAccConversationTextResource(t, "topic")
+}
+
+func TestAccConversationPurposeResource(t *testing.T) {
+	testAccConversationTextResource(t, "purpose")
+}
+
+// testConversationTextSet sets the text through the resource against a
+// conversation whose topic and purpose are both "current", and returns the
+// Slack API methods called.
+func testConversationTextSet(t *testing.T, r *conversationTextResource, text string) []string {
+	t.Helper()
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"conversations.info": func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"ok":true,"channel":{"id":"C0TEST","topic":{"value":"current"},"purpose":{"value":"current"}}}`)
+		},
+		"conversations.setTopic": func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"ok":true,"channel":{"id":"C0TEST"}}`)
+		},
+		"conversations.setPurpose": func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"ok":true,"channel":{"id":"C0TEST"}}`)
+		},
+	})
+	r.client = server.client("xoxb-test")
+
+	if _, diags := r.setText("C0TEST", text); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var methods []string
+	for _, request := range server.requestsTo("") {
+		methods = append(methods, request.method)
+	}
+	return methods
+}
+
+func TestConversationTextSet(t *testing.T) {
+	for _, tc := range []struct {
+		resource *conversationTextResource
+		method   string
+	}{
+		{NewConversationTopicResource().(*conversationTextResource), "conversations.setTopic"},
+		{NewConversationPurposeResource().(*conversationTextResource), "conversations.setPurpose"},
+	} {
+		if methods := testConversationTextSet(t, tc.resource, "current"); len(methods) != 1 {
+			t.Errorf("expected an unchanged %s not to be set, got %v", tc.resource.attribute, methods)
+		}
+		if methods := testConversationTextSet(t, tc.resource, "new"); len(methods) != 3 || methods[1] != tc.method {
+			t.Errorf("expected the %s to be set through %s, got %v", tc.resource.attribute, tc.method, methods)
+		}
+	}
+}
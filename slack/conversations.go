@@ -0,0 +1,91 @@
+package slack
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/agext/levenshtein"
+	"github.com/slack-go/slack"
+)
+
+// getAllConversations pages through conversations.list and returns every
+// conversation of the given types.
+func getAllConversations(client *slackClient, conversationTypes []string, excludeArchived bool) ([]slack.Channel, error) {
+	var conversations []slack.Channel
+
+	params := slack.GetConversationsParameters{
+		ExcludeArchived: excludeArchived,
+		Limit:           1000,
+		Types:           conversationTypes,
+	}
+
+	for {
+		page, cursor, err := client.GetConversations(&params)
+		if err != nil {
+			return nil, err
+		}
+
+		conversations = append(conversations, page...)
+
+		if cursor == "" {
+			return conversations, nil
+		}
+		params.Cursor = cursor
+	}
+}
+
+// getAllConversationMembers pages through conversations.members and returns
+// the IDs of every member of the conversation.
+func getAllConversationMembers(client *slackClient, conversationID string) ([]string, error) {
+	members := []string{}
+
+	params := slack.GetUsersInConversationParameters{
+		ChannelID: conversationID,
+		Limit:     1000,
+	}
+
+	for {
+		page, cursor, err := client.GetUsersInConversation(&params)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, page...)
+
+		if cursor == "" {
+			return members, nil
+		}
+		params.Cursor = cursor
+	}
+}
+
+// nearMatches returns up to limit candidates that contain, or are within a
+// small edit distance of, name, closest first.
+func nearMatches(name string, candidates []string, limit int) []string {
+	maxDistance := len(name) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	type match struct {
+		name     string
+		distance int
+	}
+	var matches []match
+	for _, candidate := range candidates {
+		distance := levenshtein.Distance(name, candidate, nil)
+		if distance <= maxDistance || strings.Contains(candidate, name) || strings.Contains(name, candidate) {
+			matches = append(matches, match{name: candidate, distance: distance})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].distance < matches[j].distance
+	})
+
+	var out []string
+	for i := 0; i < len(matches) && i < limit; i++ {
+		out = append(out, matches[i].name)
+	}
+	return out
+}
@@ -0,0 +1,178 @@
+package slack
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &conversationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &conversationsDataSource{}
+)
+
+// NewConversationsDataSource is a helper function to simplify the provider implementation.
+func NewConversationsDataSource() datasource.DataSource {
+	return &conversationsDataSource{}
+}
+
+// conversationsDataSource is the data source implementation.
+type conversationsDataSource struct {
+	client *slackClient
+}
+
+// conversationsDataSourceModel maps the data source schema data.
+type conversationsDataSourceModel struct {
+	ID              types.String        `tfsdk:"id"`
+	Types           types.List          `tfsdk:"types"`
+	ExcludeArchived types.Bool          `tfsdk:"exclude_archived"`
+	NamePrefix      types.String        `tfsdk:"name_prefix"`
+	NameRegex       types.String        `tfsdk:"name_regex"`
+	Conversations   []conversationModel `tfsdk:"conversations"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *conversationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*slackClients).client(tokenTypeBot, &resp.Diagnostics)
+
+}
+
+// Metadata returns the data source type name.
+func (d *conversationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversations"
+}
+
+// Schema defines the schema for the data source.
+func (d *conversationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch every conversation of the given types, optionally filtered by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"types": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "The types of conversations to return: public_channel, private_channel, mpim and/or im. Defaults to public_channel when unset or empty.",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(
+						stringvalidator.OneOf("public_channel", "private_channel", "mpim", "im"),
+					),
+				},
+			},
+			"exclude_archived": schema.BoolAttribute{
+				Description: "Whether archived conversations are left out. Defaults to false.",
+				Optional:    true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Only return conversations whose name starts with this prefix.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return conversations whose name matches this regular expression.",
+				Optional:    true,
+			},
+			"conversations": schema.ListNestedAttribute{
+				Description: "The matching conversations.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: conversationAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// conversationTypeReadScopes maps each conversation type to the scope needed
+// to list it.
+var conversationTypeReadScopes = map[string]string{
+	"public_channel":  "channels:read",
+	"private_channel": "groups:read",
+	"mpim":            "mpim:read",
+	"im":              "im:read",
+}
+
+// conversationTypeScopes lists the scopes needed to list the given
+// conversation types.
+func conversationTypeScopes(conversationTypes []string) []scopeRequirement {
+	requirements := make([]scopeRequirement, 0, len(conversationTypes))
+	for _, conversationType := range conversationTypes {
+		requirements = append(requirements, requireScope(path.Root("types"), conversationTypeReadScopes[conversationType]))
+	}
+	return requirements
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *conversationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read conversations data source")
+	var state conversationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var conversationTypes []string
+	if !state.Types.IsNull() {
+		resp.Diagnostics.Append(state.Types.ElementsAs(ctx, &conversationTypes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if len(conversationTypes) == 0 {
+		conversationTypes = []string{"public_channel"}
+	}
+
+	resp.Diagnostics.Append(d.client.checkScopes(conversationTypeScopes(conversationTypes)...)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	nameRegex := compileRegex(state.NameRegex, path.Root("name_regex"), &diags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conversations, err := getAllConversations(d.client, conversationTypes, state.ExcludeArchived.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Conversations",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.ID = types.StringValue("placeholder")
+	state.Conversations = []conversationModel{}
+	for i := range conversations {
+		if !strings.HasPrefix(conversations[i].Name, state.NamePrefix.ValueString()) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(conversations[i].Name) {
+			continue
+		}
+		state.Conversations = append(state.Conversations, newConversationModel(&conversations[i]))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Read conversations data source", map[string]any{"success": true, "count": len(state.Conversations)})
+}
@@ -0,0 +1,34 @@
+package slack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccConversationsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "slack_conversation" "test" {
+	id = "%s"
+}
+
+data "slack_conversations" "test" {
+	types      = ["public_channel", "private_channel"]
+	name_regex = "^${data.slack_conversation.test.name}$"
+}
+`, slackTestConversationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttrSet("data.slack_conversations.test", "id"),
+					resource.TestCheckResourceAttr("data.slack_conversations.test", "conversations.#", "1"),
+					resource.TestCheckResourceAttr("data.slack_conversations.test", "conversations.0.id", slackTestConversationID),
+				),
+			},
+		},
+	})
+}
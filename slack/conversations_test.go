@@ -0,0 +1,36 @@
+package slack
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNearMatches(t *testing.T) {
+	candidates := []string{"general", "random", "team-platform", "team-platfrom-alerts", "platform", "sales"}
+
+	tests := map[string]struct {
+		name     string
+		expected []string
+	}{
+		"typo": {
+			name:     "genral",
+			expected: []string{"general"},
+		},
+		"substring": {
+			name:     "platform",
+			expected: []string{"platform", "team-platform"},
+		},
+		"none": {
+			name:     "engineering-leadership",
+			expected: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := nearMatches(test.name, candidates, 5); !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package slack
+
+import (
+	"net/url"
+
+	"github.com/slack-go/slack"
+)
+
+// emojiAliasPrefix prefixes the value emoji.list reports for an alias,
+// followed by the name of the emoji it points to.
+const emojiAliasPrefix = "alias:"
+
+// addEmoji adds a custom emoji whose image Slack fetches from imageURL,
+// through admin.emoji.add.
+func (c *slackClient) addEmoji(name, imageURL string) error {
+	return c.call("admin.emoji.add", url.Values{
+		"name": {name},
+		"url":  {imageURL},
+	}, &slack.SlackResponse{})
+}
+
+// uploadEmoji adds a custom emoji from image data. admin.emoji.add only
+// accepts image URLs, so the image is uploaded through emoji.add, which the
+// Slack clients use to add emoji.
+func (c *slackClient) uploadEmoji(name, filename string, image []byte) error {
+	return c.upload("emoji.add", url.Values{
+		"name": {name},
+		"mode": {"data"},
+	}, "image", filename, image, &slack.SlackResponse{})
+}
+
+// addEmojiAlias adds alias as another name for the custom emoji name,
+// through admin.emoji.addAlias.
+func (c *slackClient) addEmojiAlias(name, alias string) error {
+	return c.call("admin.emoji.addAlias", url.Values{
+		"name":      {alias},
+		"alias_for": {name},
+	}, &slack.SlackResponse{})
+}
+
+// renameEmoji renames a custom emoji, through admin.emoji.rename.
+func (c *slackClient) renameEmoji(name, newName string) error {
+	return c.call("admin.emoji.rename", url.Values{
+		"name":     {name},
+		"new_name": {newName},
+	}, &slack.SlackResponse{})
+}
+
+// removeEmoji removes a custom emoji or alias, through admin.emoji.remove.
+func (c *slackClient) removeEmoji(name string) error {
+	return c.call("admin.emoji.remove", url.Values{
+		"name": {name},
+	}, &slack.SlackResponse{})
+}
+
+// emojiAliases returns the aliases of the custom emoji name among the emoji
+// reported by emoji.list.
+func emojiAliases(emoji map[string]string, name string) []string {
+	var aliases []string
+	for alias, value := range emoji {
+		if value == emojiAliasPrefix+name {
+			aliases = append(aliases, alias)
+		}
+	}
+	return aliases
+}
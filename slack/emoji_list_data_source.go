@@ -0,0 +1,96 @@
+package slack
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &emojiListDataSource{}
+	_ datasource.DataSourceWithConfigure = &emojiListDataSource{}
+)
+
+// NewEmojiListDataSource is a helper function to simplify the provider implementation.
+func NewEmojiListDataSource() datasource.DataSource {
+	return &emojiListDataSource{}
+}
+
+// emojiListDataSource is the data source implementation.
+type emojiListDataSource struct {
+	client *slackClient
+}
+
+// emojiListDataSourceModel maps the data source schema data.
+type emojiListDataSourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Emoji types.Map    `tfsdk:"emoji"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *emojiListDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*slackClients).client(tokenTypeBot, &resp.Diagnostics)
+}
+
+// Metadata returns the data source type name.
+func (d *emojiListDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_emoji_list"
+}
+
+// Schema defines the schema for the data source.
+func (d *emojiListDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch every custom emoji in the workspace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"emoji": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "The custom emoji keyed by name. Each value is the URL of the emoji's image, or alias: followed by " +
+					"the name of the emoji an alias points to.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *emojiListDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read emoji list data source")
+	var state emojiListDataSourceModel
+
+	resp.Diagnostics.Append(d.client.checkScopes(requireScope(path.Empty(), "emoji:read"))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	emoji, err := d.client.GetEmoji()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Emoji",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.ID = types.StringValue("placeholder")
+	emojiMap, diags := types.MapValueFrom(ctx, types.StringType, emoji)
+	resp.Diagnostics.Append(diags...)
+	state.Emoji = emojiMap
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Read emoji list data source", map[string]any{"success": true, "count": len(emoji)})
+}
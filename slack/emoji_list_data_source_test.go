@@ -0,0 +1,25 @@
+package slack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEmojiListDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "slack_emoji_list" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_emoji_list.test", "id", "placeholder"),
+					resource.TestCheckResourceAttrSet("data.slack_emoji_list.test", "emoji.%"),
+				),
+			},
+		},
+	})
+}
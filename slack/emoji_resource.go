@@ -0,0 +1,411 @@
+package slack
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &emojiResource{}
+	_ resource.ResourceWithConfigure        = &emojiResource{}
+	_ resource.ResourceWithConfigValidators = &emojiResource{}
+	_ resource.ResourceWithImportState      = &emojiResource{}
+	_ resource.ResourceWithModifyPlan       = &emojiResource{}
+)
+
+// emojiNamePattern matches the names Slack accepts for custom emoji.
+var emojiNamePattern = regexp.MustCompile(`^[a-z0-9_'+-]+$`)
+
+// NewEmojiResource is a helper function to simplify the provider implementation.
+func NewEmojiResource() resource.Resource {
+	return &emojiResource{}
+}
+
+// emojiResource is the resource implementation.
+type emojiResource struct {
+	client *slackClient
+}
+
+// emojiResourceModel maps the resource schema data.
+type emojiResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	URL         types.String `tfsdk:"url"`
+	ImagePath   types.String `tfsdk:"image_path"`
+	ImageBase64 types.String `tfsdk:"image_base64"`
+	Aliases     types.Set    `tfsdk:"aliases"`
+	ImageURL    types.String `tfsdk:"image_url"`
+}
+
+// setComputed copies the values read from emoji.list into the resource model.
+func (m *emojiResourceModel) setComputed(ctx context.Context, emoji map[string]string, name string) diag.Diagnostics {
+	m.ID = types.StringValue(name)
+	m.Name = types.StringValue(name)
+	m.ImageURL = types.StringValue(emoji[name])
+
+	var diags diag.Diagnostics
+	m.Aliases, diags = types.SetValueFrom(ctx, types.StringType, append([]string{}, emojiAliases(emoji, name)...))
+	return diags
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *emojiResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*slackClients).client(tokenTypeAdmin, &resp.Diagnostics)
+}
+
+// Metadata returns the resource type name.
+func (r *emojiResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_emoji"
+}
+
+// ConfigValidators ensures that exactly one image source is configured.
+func (r *emojiResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("url"),
+			path.MatchRoot("image_path"),
+			path.MatchRoot("image_base64"),
+		),
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *emojiResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	nameValidators := []validator.String{
+		stringvalidator.RegexMatches(emojiNamePattern, "must only contain lowercase letters, numbers, underscores, dashes, plus signs and apostrophes"),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manage a custom emoji and its aliases. Requires an admin token of an Enterprise Grid organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The name of the emoji.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the emoji, without colons. Changing this renames the emoji.",
+				Required:    true,
+				Validators:  nameValidators,
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL Slack fetches the emoji's image from, through admin.emoji.add. " +
+					"Exactly one of url, image_path and image_base64 must be set. Changing this forces a new emoji, " +
+					"unless the emoji was imported and no image source was recorded yet.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					emojiImageRequiresReplace(),
+				},
+			},
+			"image_path": schema.StringAttribute{
+				Description: "The path to a local image file to upload. Exactly one of url, image_path and image_base64 must be set. " +
+					"admin.emoji.add only accepts URLs, so images are uploaded through emoji.add, which the Slack clients use. " +
+					"That method is not part of Slack's published API and may reject the admin token, in which case host the image and use url. " +
+					"Changing this forces a new emoji, unless the emoji was imported and no image source was recorded yet.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					emojiImageRequiresReplace(),
+				},
+			},
+			"image_base64": schema.StringAttribute{
+				Description: "The base64 encoded image to upload, for example from filebase64. " +
+					"Exactly one of url, image_path and image_base64 must be set. Uploaded like image_path, with the same limitation. " +
+					"Changing this forces a new emoji, unless the emoji was imported and no image source was recorded yet.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					emojiImageRequiresReplace(),
+				},
+			},
+			"aliases": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "Other names for the emoji. Left untouched when not configured.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(nameValidators...),
+				},
+			},
+			"image_url": schema.StringAttribute{
+				Description: "The URL Slack serves the emoji's image from.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// emojiImageRequiresReplace returns a plan modifier replacing the emoji when
+// its image source changes. An imported emoji records no image source, so the
+// first one configured after an import is adopted in place.
+func emojiImageRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			for _, attribute := range []string{"url", "image_path", "image_base64"} {
+				var value types.String
+				resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(attribute), &value)...)
+				if !value.IsNull() {
+					resp.RequiresReplace = true
+					return
+				}
+			}
+		},
+		"Changing the image source forces a new emoji, unless none was recorded since the emoji was imported.",
+		"Changing the image source forces a new emoji, unless none was recorded since the emoji was imported.",
+	)
+}
+
+// ModifyPlan fails the plan early when the token lacks the scopes needed to
+// manage emoji.
+func (r *emojiResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkScopes(
+		requireScope(path.Empty(), "emoji:read"),
+		requireScope(path.Empty(), "admin.teams:write"),
+	)...)
+}
+
+// Create adds the emoji and its aliases and sets the initial Terraform state.
+func (r *emojiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create emoji resource")
+	var plan emojiResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	var err error
+	switch {
+	case !plan.URL.IsNull():
+		err = r.client.addEmoji(name, plan.URL.ValueString())
+	case !plan.ImagePath.IsNull():
+		image, readErr := os.ReadFile(plan.ImagePath.ValueString())
+		if readErr != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("image_path"),
+				"Unable to Read Emoji Image",
+				readErr.Error(),
+			)
+			return
+		}
+		err = r.client.uploadEmoji(name, filepath.Base(plan.ImagePath.ValueString()), image)
+	default:
+		image, decodeErr := base64.StdEncoding.DecodeString(plan.ImageBase64.ValueString())
+		if decodeErr != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("image_base64"),
+				"Invalid Emoji Image",
+				"The image is not valid base64: "+decodeErr.Error(),
+			)
+			return
+		}
+		err = r.client.uploadEmoji(name, name, image)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Emoji",
+			err.Error(),
+		)
+		return
+	}
+
+	if !plan.Aliases.IsUnknown() {
+		var aliases []string
+		resp.Diagnostics.Append(plan.Aliases.ElementsAs(ctx, &aliases, false)...)
+		resp.Diagnostics.Append(r.updateAliases(name, nil, aliases)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	emoji, err := r.client.GetEmoji()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Emoji",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(plan.setComputed(ctx, emoji, name)...)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Created emoji resource", map[string]any{"success": true})
+}
+
+// updateAliases adds the desired aliases missing from current and removes
+// the current aliases no longer desired.
+func (r *emojiResource) updateAliases(name string, current, desired []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, alias := range difference(desired, current) {
+		if err := r.client.addEmojiAlias(name, alias); err != nil {
+			diags.AddAttributeError(
+				path.Root("aliases"),
+				"Unable to Add Emoji Alias",
+				"Adding alias "+alias+" failed: "+err.Error(),
+			)
+		}
+	}
+	for _, alias := range difference(current, desired) {
+		if err := r.client.removeEmoji(alias); err != nil && !isSlackError(err, "emoji_not_found") {
+			diags.AddAttributeError(
+				path.Root("aliases"),
+				"Unable to Remove Emoji Alias",
+				"Removing alias "+alias+" failed: "+err.Error(),
+			)
+		}
+	}
+
+	return diags
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *emojiResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read emoji resource")
+	var state emojiResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	emoji, err := r.client.GetEmoji()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Emoji",
+			err.Error(),
+		)
+		return
+	}
+
+	name := state.ID.ValueString()
+	if _, ok := emoji[name]; !ok {
+		tflog.Info(ctx, "Emoji no longer exists, removing from state", map[string]any{"name": name})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(state.setComputed(ctx, emoji, name)...)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Read emoji resource", map[string]any{"success": true})
+}
+
+// Update renames the emoji and updates its aliases, and sets the updated
+// Terraform state on success.
+func (r *emojiResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update emoji resource")
+	var plan, state emojiResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	if !plan.Name.Equal(state.Name) {
+		if err := r.client.renameEmoji(state.Name.ValueString(), name); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Unable to Rename Emoji",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Aliases.IsUnknown() && !plan.Aliases.Equal(state.Aliases) {
+		var current, desired []string
+		resp.Diagnostics.Append(state.Aliases.ElementsAs(ctx, &current, false)...)
+		resp.Diagnostics.Append(plan.Aliases.ElementsAs(ctx, &desired, false)...)
+		resp.Diagnostics.Append(r.updateAliases(name, current, desired)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	emoji, err := r.client.GetEmoji()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Emoji",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(plan.setComputed(ctx, emoji, name)...)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Updated emoji resource", map[string]any{"success": true})
+}
+
+// Delete removes the emoji and its aliases and the Terraform state on success.
+func (r *emojiResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete emoji resource")
+	var state emojiResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var aliases []string
+	resp.Diagnostics.Append(state.Aliases.ElementsAs(ctx, &aliases, false)...)
+	resp.Diagnostics.Append(r.updateAliases(state.Name.ValueString(), aliases, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.removeEmoji(state.Name.ValueString())
+	if err != nil && !isSlackError(err, "emoji_not_found") {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Emoji",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted emoji resource", map[string]any{"success": true})
+}
+
+// ImportState imports an existing emoji by its name. Slack does not report
+// the image source, so the one configured is recorded on the next apply
+// without replacing the emoji.
+func (r *emojiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
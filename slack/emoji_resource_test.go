@@ -0,0 +1,81 @@
+package slack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testEmojiImageRequiresReplace reports whether configuring url replaces an
+// emoji whose state records the given image source attributes.
+func testEmojiImageRequiresReplace(t *testing.T, state map[string]tftypes.Value) bool {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&emojiResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	object := func(set map[string]tftypes.Value) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+		values["id"] = tftypes.NewValue(tftypes.String, "sev1")
+		values["name"] = tftypes.NewValue(tftypes.String, "sev1")
+		for name, value := range set {
+			values[name] = value
+		}
+		return tftypes.NewValue(objectType, values)
+	}
+
+	configured := map[string]tftypes.Value{"url": tftypes.NewValue(tftypes.String, "https://example.com/sev1.png")}
+	req := planmodifier.StringRequest{
+		Path:        path.Root("url"),
+		Config:      tfsdk.Config{Schema: schemaResp.Schema, Raw: object(configured)},
+		ConfigValue: types.StringValue("https://example.com/sev1.png"),
+		Plan:        tfsdk.Plan{Schema: schemaResp.Schema, Raw: object(configured)},
+		PlanValue:   types.StringValue("https://example.com/sev1.png"),
+		State:       tfsdk.State{Schema: schemaResp.Schema, Raw: object(state)},
+		StateValue:  types.StringNull(),
+	}
+	if value, ok := state["url"]; ok {
+		var stateValue string
+		if err := value.As(&stateValue); err != nil {
+			t.Fatal(err)
+		}
+		req.StateValue = types.StringValue(stateValue)
+	}
+
+	resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+	emojiImageRequiresReplace().PlanModifyString(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	return resp.RequiresReplace
+}
+
+func TestEmojiImageRequiresReplaceImported(t *testing.T) {
+	if testEmojiImageRequiresReplace(t, nil) {
+		t.Error("expected the image source of an imported emoji to be adopted in place")
+	}
+}
+
+func TestEmojiImageRequiresReplaceChanged(t *testing.T) {
+	if !testEmojiImageRequiresReplace(t, map[string]tftypes.Value{
+		"image_path": tftypes.NewValue(tftypes.String, "sev1.png"),
+	}) {
+		t.Error("expected a changed image source to replace the emoji")
+	}
+	if !testEmojiImageRequiresReplace(t, map[string]tftypes.Value{
+		"url": tftypes.NewValue(tftypes.String, "https://example.com/old.png"),
+	}) {
+		t.Error("expected a changed url to replace the emoji")
+	}
+}
@@ -0,0 +1,71 @@
+package slack
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+)
+
+func TestAddEmoji(t *testing.T) {
+	server := newTestServer(t, nil)
+	client := server.client("xoxp-admin")
+
+	if err := client.addEmoji("sev1", "https://example.com/sev1.png"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.addEmojiAlias("sev1", "severity-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := server.requestsTo("")
+	if len(requests) != 2 {
+		t.Fatalf("expected two requests, got %v", requests)
+	}
+	add, alias := requests[0], requests[1]
+	if add.method != "admin.emoji.add" || add.form.Get("name") != "sev1" || add.form.Get("url") != "https://example.com/sev1.png" || add.form.Get("token") != "xoxp-admin" {
+		t.Errorf("unexpected admin.emoji.add request %+v", add)
+	}
+	if alias.method != "admin.emoji.addAlias" || alias.form.Get("name") != "severity-1" || alias.form.Get("alias_for") != "sev1" {
+		t.Errorf("unexpected admin.emoji.addAlias request %+v", alias)
+	}
+}
+
+func TestUploadEmoji(t *testing.T) {
+	server := newTestServer(t, nil)
+	client := server.client("xoxp-admin")
+
+	if err := client.uploadEmoji("sev1", "sev1.png", []byte("image data")); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := server.requestsTo("")
+	if len(requests) != 1 {
+		t.Fatalf("expected one request, got %v", requests)
+	}
+	upload := requests[0]
+	if upload.method != "emoji.add" || upload.form.Get("name") != "sev1" || upload.form.Get("mode") != "data" {
+		t.Errorf("unexpected emoji.add request %+v", upload)
+	}
+	if upload.files["image"] != "image data" {
+		t.Errorf("expected the image to be uploaded, got %q", upload.files["image"])
+	}
+	if upload.authorization != "Bearer xoxp-admin" {
+		t.Errorf("expected the token as a bearer token, got %q", upload.authorization)
+	}
+}
+
+func TestEmojiAliases(t *testing.T) {
+	emoji := map[string]string{
+		"sev1":       "https://example.com/sev1.png",
+		"severity-1": "alias:sev1",
+		"s1":         "alias:sev1",
+		"sev2":       "https://example.com/sev2.png",
+		"severity-2": "alias:sev2",
+	}
+
+	aliases := emojiAliases(emoji, "sev1")
+	sort.Strings(aliases)
+	if fmt.Sprint(aliases) != "[s1 severity-1]" {
+		t.Errorf("expected the aliases of sev1, got %v", aliases)
+	}
+}
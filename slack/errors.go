@@ -0,0 +1,24 @@
+package slack
+
+import (
+	"errors"
+
+	"github.com/slack-go/slack"
+)
+
+// isSlackError reports whether err is a Slack API error response
+// carrying one of the given error codes (e.g. "channel_not_found").
+func isSlackError(err error, codes ...string) bool {
+	var slackErr slack.SlackErrorResponse
+	if !errors.As(err, &slackErr) {
+		return false
+	}
+
+	for _, code := range codes {
+		if slackErr.Err == code {
+			return true
+		}
+	}
+
+	return false
+}
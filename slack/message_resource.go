@@ -0,0 +1,364 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &messageResource{}
+	_ resource.ResourceWithConfigure        = &messageResource{}
+	_ resource.ResourceWithConfigValidators = &messageResource{}
+	_ resource.ResourceWithImportState      = &messageResource{}
+	_ resource.ResourceWithModifyPlan       = &messageResource{}
+)
+
+// messageChannelIDPattern matches the IDs of public and private channels and
+// direct messages. chat.postMessage also accepts a user ID, but posts to the
+// user's direct message conversation, whose ID the message is then read,
+// updated and deleted through instead.
+var messageChannelIDPattern = regexp.MustCompile(`^[CGD][A-Z0-9]+$`)
+
+// NewMessageResource is a helper function to simplify the provider implementation.
+func NewMessageResource() resource.Resource {
+	return &messageResource{}
+}
+
+// messageResource is the resource implementation.
+type messageResource struct {
+	client *slackClient
+}
+
+// messageResourceModel maps the resource schema data.
+type messageResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ChannelID types.String `tfsdk:"channel_id"`
+	Text      types.String `tfsdk:"text"`
+	Blocks    types.String `tfsdk:"blocks"`
+}
+
+// options returns the chat.postMessage and chat.update options sending the
+// message's content.
+func (m messageResourceModel) options() ([]slack.MsgOption, error) {
+	options := []slack.MsgOption{slack.MsgOptionText(m.Text.ValueString(), false)}
+	if m.Blocks.IsNull() {
+		return options, nil
+	}
+
+	blocks, err := parseBlocks(m.Blocks.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	return append(options, slack.MsgOptionBlocks(blocks...)), nil
+}
+
+// rawBlock is a Block Kit block sent to Slack exactly as configured, so that
+// block types and fields the Slack client does not model are preserved.
+type rawBlock struct {
+	blockType string
+	raw       json.RawMessage
+}
+
+// BlockType returns the type of the block.
+func (b rawBlock) BlockType() slack.MessageBlockType {
+	return slack.MessageBlockType(b.blockType)
+}
+
+// MarshalJSON returns the block as configured.
+func (b rawBlock) MarshalJSON() ([]byte, error) {
+	return b.raw, nil
+}
+
+// parseBlocks parses a JSON array of Block Kit blocks.
+func parseBlocks(value string) ([]slack.Block, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal([]byte(value), &raw); err != nil {
+		return nil, err
+	}
+
+	blocks := make([]slack.Block, 0, len(raw))
+	for i, r := range raw {
+		var typed struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(r, &typed); err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+		if typed.Type == "" {
+			return nil, fmt.Errorf("block %d has no type", i)
+		}
+		blocks = append(blocks, rawBlock{blockType: typed.Type, raw: r})
+	}
+	return blocks, nil
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *messageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*slackClients).client(tokenTypeBot, &resp.Diagnostics)
+}
+
+// Metadata returns the resource type name.
+func (r *messageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_message"
+}
+
+// ConfigValidators ensures that the message has some content.
+func (r *messageResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("text"),
+			path.MatchRoot("blocks"),
+		),
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *messageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a message posted by the app's bot, such as a runbook or read me first message. " +
+			"Changes to the content are applied to the message in place. Only the message's existence is read back from Slack, " +
+			"so edits made outside of Terraform are not detected.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ts of the message, which identifies it within its channel.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the conversation to post the message in: a channel (C or G) or a direct message (D). " +
+					"User IDs are not accepted, as Slack posts to the user's direct message instead. Changing this forces a new message.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(messageChannelIDPattern, "must be the ID of a channel or direct message, starting with C, G or D"),
+				},
+			},
+			"text": schema.StringAttribute{
+				Description: "The text of the message, in Slack's mrkdwn format. When blocks is set, this is the fallback shown in notifications. " +
+					"At least one of text and blocks must be set.",
+				Optional: true,
+			},
+			"blocks": schema.StringAttribute{
+				Description: "The layout of the message as a JSON array of Block Kit blocks, for example from jsonencode or Block Kit Builder. " +
+					"At least one of text and blocks must be set.",
+				Optional: true,
+				Validators: []validator.String{
+					blocksJSON(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan fails the plan early when the token lacks the scopes needed to
+// post and read back messages.
+func (r *messageResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkScopes(
+		requireScope(path.Empty(), "chat:write"),
+		requireScope(path.Root("channel_id"), "channels:history", "groups:history", "im:history", "mpim:history"),
+	)...)
+}
+
+// Create posts the message and sets the initial Terraform state.
+func (r *messageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create message resource")
+	var plan messageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, err := plan.options()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("blocks"),
+			"Invalid Block Kit JSON",
+			err.Error(),
+		)
+		return
+	}
+
+	_, ts, err := r.client.PostMessage(plan.ChannelID.ValueString(), options...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Post Message",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(ts)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Created message resource", map[string]any{"success": true, "ts": ts})
+}
+
+// Read removes the message from the Terraform state when it no longer exists.
+func (r *messageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read message resource")
+	var state messageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ts := state.ID.ValueString()
+	history, err := r.client.GetConversationHistory(&slack.GetConversationHistoryParameters{
+		ChannelID: state.ChannelID.ValueString(),
+		Latest:    ts,
+		Oldest:    ts,
+		Inclusive: true,
+		Limit:     1,
+	})
+	if isSlackError(err, "channel_not_found") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Message",
+			err.Error(),
+		)
+		return
+	}
+
+	found := false
+	for _, message := range history.Messages {
+		if message.Timestamp == ts {
+			found = true
+			break
+		}
+	}
+	if !found {
+		tflog.Info(ctx, "Message no longer exists, removing from state", map[string]any{"ts": ts})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Read message resource", map[string]any{"success": true})
+}
+
+// Update edits the message in place and sets the updated Terraform state on success.
+func (r *messageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update message resource")
+	var plan, state messageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, err := plan.options()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("blocks"),
+			"Invalid Block Kit JSON",
+			err.Error(),
+		)
+		return
+	}
+	// Slack keeps the existing blocks unless they are explicitly emptied.
+	if plan.Blocks.IsNull() && !state.Blocks.IsNull() {
+		options = append(options, slack.MsgOptionBlocks([]slack.Block{}...))
+	}
+
+	_, _, _, err = r.client.UpdateMessage(state.ChannelID.ValueString(), state.ID.ValueString(), options...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Message",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Updated message resource", map[string]any{"success": true})
+}
+
+// Delete deletes the message and removes the Terraform state on success.
+func (r *messageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete message resource")
+	var state messageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.DeleteMessage(state.ChannelID.ValueString(), state.ID.ValueString())
+	if err != nil && !isSlackError(err, "message_not_found", "channel_not_found") {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Message",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted message resource", map[string]any{"success": true})
+}
+
+// ImportState imports an existing message by its channel ID and ts, in the
+// form channel_id/ts. The content is taken from the configuration on the next
+// apply.
+func (r *messageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	channelID, ts, err := parseImportID(req.ID, "channel_id", "ts")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ts)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), channelID)...)
+}
+
+// parseImportID splits an import identifier made of two slash separated
+// parts, named in errors by first and second.
+func parseImportID(id, first, second string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected an import identifier of the form %s/%s, got %q", first, second, id)
+	}
+	return parts[0], parts[1], nil
+}
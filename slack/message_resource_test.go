@@ -0,0 +1,113 @@
+package slack
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestParseBlocks(t *testing.T) {
+	value := `[{"type":"section","text":{"type":"mrkdwn","text":"*Runbook*"}},{"type":"rich_text","elements":[]}]`
+
+	blocks, err := parseBlocks(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(blocks) != 2 || blocks[0].BlockType() != "section" || blocks[1].BlockType() != "rich_text" {
+		t.Fatalf("expected a section and a rich_text block, got %v", blocks)
+	}
+
+	// Blocks are sent exactly as configured, whatever their type.
+	encoded, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) != value {
+		t.Errorf("expected the blocks to be sent as configured, got %s", encoded)
+	}
+
+	for _, invalid := range []string{`{"type":"section"}`, `[{"text":"no type"}]`, `[1]`, `not json`} {
+		if _, err := parseBlocks(invalid); err == nil {
+			t.Errorf("expected an error for %s", invalid)
+		}
+	}
+}
+
+func TestParseImportID(t *testing.T) {
+	channelID, ts, err := parseImportID("C99ZZ999ZZZ/1700000000.000100", "channel_id", "ts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if channelID != "C99ZZ999ZZZ" || ts != "1700000000.000100" {
+		t.Errorf("expected the channel ID and ts, got %q and %q", channelID, ts)
+	}
+
+	for _, invalid := range []string{"C99ZZ999ZZZ", "C99ZZ999ZZZ/", "/1700000000.000100", "a/b/c"} {
+		if _, _, err := parseImportID(invalid, "channel_id", "ts"); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestAccMessageResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "slack_message" "test" {
+	channel_id = "%s"
+	text       = "Posted by Terraform"
+}
+`, slackTestConversationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("slack_message.test", "id"),
+					resource.TestCheckResourceAttr("slack_message.test", "channel_id", slackTestConversationID),
+					resource.TestCheckResourceAttr("slack_message.test", "text", "Posted by Terraform"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "slack_message.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccMessageImportID("slack_message.test"),
+				ImportStateVerify: true,
+				// Only the message's existence is read back from Slack.
+				ImportStateVerifyIgnore: []string{"text", "blocks"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "slack_message" "test" {
+	channel_id = "%s"
+	text       = "Updated by Terraform"
+	blocks = jsonencode([{
+		type = "section"
+		text = { type = "mrkdwn", text = "*Updated* by Terraform" }
+	}])
+}
+`, slackTestConversationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_message.test", "text", "Updated by Terraform"),
+					resource.TestCheckResourceAttrSet("slack_message.test", "blocks"),
+				),
+			},
+		},
+	})
+}
+
+// testAccMessageImportID returns the channel_id/ts import identifier of the
+// named message resource.
+func testAccMessageImportID(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", name)
+		}
+		return rs.Primary.Attributes["channel_id"] + "/" + rs.Primary.ID, nil
+	}
+}
@@ -0,0 +1,249 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource                     = &oauthTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure        = &oauthTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigValidators = &oauthTokenEphemeralResource{}
+)
+
+// NewOAuthTokenEphemeralResource is a helper function to simplify the provider implementation.
+func NewOAuthTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &oauthTokenEphemeralResource{}
+}
+
+// oauthTokenEphemeralResource is the ephemeral resource implementation. It
+// has no scope checks, as oauth.v2.access authenticates with the app's
+// client credentials rather than the provider's tokens and needs no scope.
+type oauthTokenEphemeralResource struct {
+	clients *slackClients
+}
+
+// oauthTokenEphemeralResourceModel maps the ephemeral resource schema data.
+type oauthTokenEphemeralResourceModel struct {
+	ClientID        types.String `tfsdk:"client_id"`
+	ClientSecret    types.String `tfsdk:"client_secret"`
+	Code            types.String `tfsdk:"code"`
+	RedirectURI     types.String `tfsdk:"redirect_uri"`
+	RefreshToken    types.String `tfsdk:"refresh_token"`
+	AccessToken     types.String `tfsdk:"access_token"`
+	TokenType       types.String `tfsdk:"token_type"`
+	Scope           types.String `tfsdk:"scope"`
+	ExpiresIn       types.Int64  `tfsdk:"expires_in"`
+	NewRefreshToken types.String `tfsdk:"new_refresh_token"`
+	AppID           types.String `tfsdk:"app_id"`
+	BotUserID       types.String `tfsdk:"bot_user_id"`
+	TeamID          types.String `tfsdk:"team_id"`
+	TeamName        types.String `tfsdk:"team_name"`
+	EnterpriseID    types.String `tfsdk:"enterprise_id"`
+	AuthedUserID    types.String `tfsdk:"authed_user_id"`
+
+	AuthedUserAccessToken  types.String `tfsdk:"authed_user_access_token"`
+	AuthedUserScope        types.String `tfsdk:"authed_user_scope"`
+	AuthedUserExpiresIn    types.Int64  `tfsdk:"authed_user_expires_in"`
+	AuthedUserRefreshToken types.String `tfsdk:"authed_user_refresh_token"`
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (r *oauthTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.clients = req.ProviderData.(*slackClients)
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *oauthTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oauth_token"
+}
+
+// ConfigValidators ensures that exactly one grant is configured.
+func (r *oauthTokenEphemeralResource) ConfigValidators(_ context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(
+			path.MatchRoot("code"),
+			path.MatchRoot("refresh_token"),
+		),
+	}
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *oauthTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exchange an OAuth authorization code or refresh token for a Slack access token through oauth.v2.access. " +
+			"The token is never stored in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				Description: "The client ID of the Slack app.",
+				Required:    true,
+			},
+			"client_secret": schema.StringAttribute{
+				Description: "The client secret of the Slack app.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"code": schema.StringAttribute{
+				Description: "An authorization code from the OAuth flow. Conflicts with refresh_token.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"redirect_uri": schema.StringAttribute{
+				Description: "The redirect URI the authorization code was issued for, if the OAuth flow used one.",
+				Optional:    true,
+			},
+			"refresh_token": schema.StringAttribute{
+				Description: "A refresh token of a Slack app with token rotation enabled. Conflicts with code.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"access_token": schema.StringAttribute{
+				Description: "The access token. Empty when the OAuth flow only requested user scopes, whose token is authed_user_access_token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"token_type": schema.StringAttribute{
+				Description: "The type of the access token: bot or user.",
+				Computed:    true,
+			},
+			"scope": schema.StringAttribute{
+				Description: "The comma separated scopes granted to the access token.",
+				Computed:    true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Description: "The number of seconds until the access token expires, when token rotation is enabled.",
+				Computed:    true,
+			},
+			"new_refresh_token": schema.StringAttribute{
+				Description: "The refresh token to use for the next exchange, when token rotation is enabled.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"app_id": schema.StringAttribute{
+				Description: "Identifier for the Slack app.",
+				Computed:    true,
+			},
+			"bot_user_id": schema.StringAttribute{
+				Description: "Identifier for the app's bot user.",
+				Computed:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Description: "Identifier for the workspace the app is installed in.",
+				Computed:    true,
+			},
+			"team_name": schema.StringAttribute{
+				Description: "The name of the workspace the app is installed in.",
+				Computed:    true,
+			},
+			"enterprise_id": schema.StringAttribute{
+				Description: "Identifier for the Enterprise Grid organization the app is installed in, if any.",
+				Computed:    true,
+			},
+			"authed_user_id": schema.StringAttribute{
+				Description: "Identifier for the user who installed the app.",
+				Computed:    true,
+			},
+			"authed_user_access_token": schema.StringAttribute{
+				Description: "The user token issued for the user who installed the app, when the OAuth flow requested user scopes.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"authed_user_scope": schema.StringAttribute{
+				Description: "The comma separated scopes granted to the user token.",
+				Computed:    true,
+			},
+			"authed_user_expires_in": schema.Int64Attribute{
+				Description: "The number of seconds until the user token expires, when token rotation is enabled.",
+				Computed:    true,
+			},
+			"authed_user_refresh_token": schema.StringAttribute{
+				Description: "The refresh token to use for the next exchange of the user token, when token rotation is enabled.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Open exchanges the configured grant for an access token.
+func (r *oauthTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	tflog.Debug(ctx, "Preparing to open OAuth token ephemeral resource")
+	var config oauthTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.clients == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Slack Provider",
+			"The OAuth grant cannot be exchanged before the provider is configured. "+
+				"This is a bug in the provider or Terraform, and should be reported.",
+		)
+		return
+	}
+
+	values := url.Values{
+		"client_id":     {config.ClientID.ValueString()},
+		"client_secret": {config.ClientSecret.ValueString()},
+	}
+	if !config.Code.IsNull() {
+		values.Set("code", config.Code.ValueString())
+		if !config.RedirectURI.IsNull() {
+			values.Set("redirect_uri", config.RedirectURI.ValueString())
+		}
+	} else {
+		values.Set("grant_type", "refresh_token")
+		values.Set("refresh_token", config.RefreshToken.ValueString())
+	}
+
+	response, err := oauthV2Access(r.clients.httpClient, r.clients.apiURL, values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Exchange OAuth Grant",
+			err.Error(),
+		)
+		return
+	}
+	if response.AccessToken == "" && response.AuthedUser.AccessToken == "" {
+		resp.Diagnostics.AddError(
+			"Unable to Exchange OAuth Grant",
+			"Slack issued no access token for the grant.",
+		)
+		return
+	}
+
+	// Map response body to model
+	config.AccessToken = types.StringValue(response.AccessToken)
+	config.TokenType = types.StringValue(response.TokenType)
+	config.Scope = types.StringValue(response.Scope)
+	config.ExpiresIn = types.Int64Value(int64(response.ExpiresIn))
+	config.NewRefreshToken = types.StringValue(response.RefreshToken)
+	config.AppID = types.StringValue(response.AppID)
+	config.BotUserID = types.StringValue(response.BotUserID)
+	config.TeamID = types.StringValue(response.Team.ID)
+	config.TeamName = types.StringValue(response.Team.Name)
+	config.EnterpriseID = types.StringValue(response.Enterprise.ID)
+	config.AuthedUserID = types.StringValue(response.AuthedUser.ID)
+	config.AuthedUserAccessToken = types.StringValue(response.AuthedUser.AccessToken)
+	config.AuthedUserScope = types.StringValue(response.AuthedUser.Scope)
+	config.AuthedUserExpiresIn = types.Int64Value(int64(response.AuthedUser.ExpiresIn))
+	config.AuthedUserRefreshToken = types.StringValue(response.AuthedUser.RefreshToken)
+
+	// Set result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+	tflog.Debug(ctx, "Opened OAuth token ephemeral resource", map[string]any{"success": true, "token_type": response.TokenType})
+}
@@ -0,0 +1,189 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testOAuthTokenOpen opens the slack_oauth_token ephemeral resource against
+// the given API URL with the given attribute values, leaving every other
+// attribute null.
+func testOAuthTokenOpen(t *testing.T, apiURL string, config map[string]tftypes.Value) (*ephemeral.OpenResponse, oauthTokenEphemeralResourceModel) {
+	t.Helper()
+	r := &oauthTokenEphemeralResource{clients: &slackClients{httpClient: &http.Client{}, apiURL: apiURL}}
+	return testOAuthTokenOpenWith(t, r, config)
+}
+
+// testOAuthTokenOpenWith opens the given slack_oauth_token ephemeral resource
+// with the given attribute values, leaving every other attribute null.
+func testOAuthTokenOpenWith(t *testing.T, r *oauthTokenEphemeralResource, config map[string]tftypes.Value) (*ephemeral.OpenResponse, oauthTokenEphemeralResourceModel) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := config[name]; ok {
+			values[name] = value
+		} else {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+	r.Open(ctx, ephemeral.OpenRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, values),
+		},
+	}, resp)
+
+	var result oauthTokenEphemeralResourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.Result.Get(ctx, &result)...)
+	}
+	return resp, result
+}
+
+// testOAuthServer starts a testServer answering oauth.v2.access for the
+// client secret "secret". The codes "user" and "none" exchange for only a user
+// token and for no token.
+func testOAuthServer(t *testing.T) *testServer {
+	t.Helper()
+	return newTestServer(t, map[string]http.HandlerFunc{
+		"oauth.v2.access": func(w http.ResponseWriter, r *http.Request) {
+			if r.FormValue("client_secret") != "secret" {
+				fmt.Fprint(w, `{"ok":false,"error":"invalid_client_id"}`)
+				return
+			}
+			switch r.FormValue("code") {
+			case "user":
+				fmt.Fprint(w, `{"ok":true,"app_id":"A0TEST","team":{"id":"T0TEST","name":"Test"},`+
+					`"authed_user":{"id":"U0TEST","scope":"users:read","access_token":"xoxp-issued","token_type":"user","expires_in":43200,"refresh_token":"xoxe-2"}}`)
+				return
+			case "none":
+				fmt.Fprint(w, `{"ok":true,"app_id":"A0TEST","team":{"id":"T0TEST","name":"Test"},"authed_user":{"id":"U0TEST"}}`)
+				return
+			}
+			fmt.Fprint(w, `{"ok":true,"access_token":"xoxb-issued","token_type":"bot","scope":"channels:read","expires_in":43200,`+
+				`"refresh_token":"xoxe-1","app_id":"A0TEST","bot_user_id":"U0BOT","team":{"id":"T0TEST","name":"Test"},"authed_user":{"id":"U0TEST"}}`)
+		},
+	})
+}
+
+func TestOAuthTokenEphemeralResourceCode(t *testing.T) {
+	server := testOAuthServer(t)
+
+	resp, result := testOAuthTokenOpen(t, server.apiURL(), map[string]tftypes.Value{
+		"client_id":     tftypes.NewValue(tftypes.String, "client"),
+		"client_secret": tftypes.NewValue(tftypes.String, "secret"),
+		"code":          tftypes.NewValue(tftypes.String, "code"),
+		"redirect_uri":  tftypes.NewValue(tftypes.String, "https://example.com/callback"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	form := server.requestsTo("oauth.v2.access")[0].form
+	if form.Get("code") != "code" || form.Get("redirect_uri") != "https://example.com/callback" || form.Has("grant_type") {
+		t.Errorf("expected an authorization code exchange, got %v", form)
+	}
+	if result.AccessToken.ValueString() != "xoxb-issued" || result.NewRefreshToken.ValueString() != "xoxe-1" {
+		t.Errorf("expected the issued tokens, got %q and %q", result.AccessToken.ValueString(), result.NewRefreshToken.ValueString())
+	}
+	if result.TeamID.ValueString() != "T0TEST" || result.AuthedUserID.ValueString() != "U0TEST" || result.ExpiresIn.ValueInt64() != 43200 {
+		t.Errorf("expected the installation details to be mapped, got %+v", result)
+	}
+}
+
+func TestOAuthTokenEphemeralResourceRefreshToken(t *testing.T) {
+	server := testOAuthServer(t)
+
+	resp, result := testOAuthTokenOpen(t, server.apiURL(), map[string]tftypes.Value{
+		"client_id":     tftypes.NewValue(tftypes.String, "client"),
+		"client_secret": tftypes.NewValue(tftypes.String, "secret"),
+		"refresh_token": tftypes.NewValue(tftypes.String, "xoxe-0"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	form := server.requestsTo("oauth.v2.access")[0].form
+	if form.Get("grant_type") != "refresh_token" || form.Get("refresh_token") != "xoxe-0" {
+		t.Errorf("expected a refresh token exchange, got %v", form)
+	}
+	if result.AccessToken.ValueString() != "xoxb-issued" {
+		t.Errorf("expected the issued access token, got %q", result.AccessToken.ValueString())
+	}
+}
+
+func TestOAuthTokenEphemeralResourceError(t *testing.T) {
+	server := testOAuthServer(t)
+
+	resp, _ := testOAuthTokenOpen(t, server.apiURL(), map[string]tftypes.Value{
+		"client_id":     tftypes.NewValue(tftypes.String, "client"),
+		"client_secret": tftypes.NewValue(tftypes.String, "wrong"),
+		"code":          tftypes.NewValue(tftypes.String, "code"),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for rejected client credentials")
+	}
+}
+
+func TestOAuthTokenEphemeralResourceUserToken(t *testing.T) {
+	server := testOAuthServer(t)
+
+	resp, result := testOAuthTokenOpen(t, server.apiURL(), map[string]tftypes.Value{
+		"client_id":     tftypes.NewValue(tftypes.String, "client"),
+		"client_secret": tftypes.NewValue(tftypes.String, "secret"),
+		"code":          tftypes.NewValue(tftypes.String, "user"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if result.AuthedUserAccessToken.ValueString() != "xoxp-issued" || result.AuthedUserRefreshToken.ValueString() != "xoxe-2" {
+		t.Errorf("expected the issued user tokens, got %q and %q", result.AuthedUserAccessToken.ValueString(), result.AuthedUserRefreshToken.ValueString())
+	}
+	if result.AuthedUserScope.ValueString() != "users:read" || result.AuthedUserExpiresIn.ValueInt64() != 43200 {
+		t.Errorf("expected the user token details to be mapped, got %+v", result)
+	}
+}
+
+func TestOAuthTokenEphemeralResourceNoToken(t *testing.T) {
+	server := testOAuthServer(t)
+
+	resp, _ := testOAuthTokenOpen(t, server.apiURL(), map[string]tftypes.Value{
+		"client_id":     tftypes.NewValue(tftypes.String, "client"),
+		"client_secret": tftypes.NewValue(tftypes.String, "secret"),
+		"code":          tftypes.NewValue(tftypes.String, "none"),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for an exchange issuing no token")
+	}
+}
+
+func TestOAuthTokenEphemeralResourceUnconfigured(t *testing.T) {
+	resp, _ := testOAuthTokenOpenWith(t, &oauthTokenEphemeralResource{}, map[string]tftypes.Value{
+		"client_id":     tftypes.NewValue(tftypes.String, "client"),
+		"client_secret": tftypes.NewValue(tftypes.String, "secret"),
+		"code":          tftypes.NewValue(tftypes.String, "code"),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error before the provider is configured")
+	}
+}
@@ -0,0 +1,225 @@
+package slack
+
+import (
+	"context"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &pinResource{}
+	_ resource.ResourceWithConfigure   = &pinResource{}
+	_ resource.ResourceWithImportState = &pinResource{}
+	_ resource.ResourceWithModifyPlan  = &pinResource{}
+)
+
+// NewPinResource is a helper function to simplify the provider implementation.
+func NewPinResource() resource.Resource {
+	return &pinResource{}
+}
+
+// pinResource is the resource implementation.
+type pinResource struct {
+	client *slackClient
+}
+
+// pinResourceModel maps the resource schema data.
+type pinResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ChannelID types.String `tfsdk:"channel_id"`
+	Timestamp types.String `tfsdk:"timestamp"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *pinResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*slackClients).client(tokenTypeBot, &resp.Diagnostics)
+}
+
+// Metadata returns the resource type name.
+func (r *pinResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pin"
+}
+
+// Schema defines the schema for the resource.
+func (r *pinResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Pin a message to a conversation. A pin removed outside of Terraform is pinned again on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier for this pin, in the form channel_id/timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the conversation the message was posted in. Changing this forces a new pin.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"timestamp": schema.StringAttribute{
+				Description: "The ts of the message to pin, such as the id of a slack_message. Changing this forces a new pin.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan fails the plan early when the token lacks the scopes needed to
+// manage pins.
+func (r *pinResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkScopes(
+		requireScope(path.Empty(), "pins:read"),
+		requireScope(path.Empty(), "pins:write"),
+	)...)
+}
+
+// Create pins the message and sets the initial Terraform state.
+func (r *pinResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create pin resource")
+	var plan pinResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := plan.ChannelID.ValueString()
+	timestamp := plan.Timestamp.ValueString()
+
+	err := r.client.AddPin(channelID, slack.NewRefToMessage(channelID, timestamp))
+	if err != nil && !isSlackError(err, "already_pinned") {
+		resp.Diagnostics.AddError(
+			"Unable to Pin Message",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(channelID + "/" + timestamp)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Created pin resource", map[string]any{"success": true})
+}
+
+// Read removes the pin from the Terraform state when the message is no
+// longer pinned.
+func (r *pinResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read pin resource")
+	var state pinResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, _, err := r.client.ListPins(state.ChannelID.ValueString())
+	if isSlackError(err, "channel_not_found") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Pins",
+			err.Error(),
+		)
+		return
+	}
+
+	if !isPinned(items, state.Timestamp.ValueString()) {
+		tflog.Info(ctx, "Message is no longer pinned, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Read pin resource", map[string]any{"success": true})
+}
+
+// isPinned reports whether the message with the given ts is among the
+// pinned items.
+func isPinned(items []slack.Item, timestamp string) bool {
+	for _, item := range items {
+		if item.Type == slack.TYPE_MESSAGE && item.Message != nil && item.Message.Timestamp == timestamp {
+			return true
+		}
+	}
+	return false
+}
+
+// Update only carries the state forward, since every attribute forces a new pin.
+func (r *pinResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan pinResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete unpins the message and removes the Terraform state on success.
+func (r *pinResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete pin resource")
+	var state pinResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := state.ChannelID.ValueString()
+	err := r.client.RemovePin(channelID, slack.NewRefToMessage(channelID, state.Timestamp.ValueString()))
+	if err != nil && !isSlackError(err, "no_pin", "message_not_found", "channel_not_found") {
+		resp.Diagnostics.AddError(
+			"Unable to Unpin Message",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted pin resource", map[string]any{"success": true})
+}
+
+// ImportState imports an existing pin by its channel ID and message ts, in
+// the form channel_id/timestamp.
+func (r *pinResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	channelID, timestamp, err := parseImportID(req.ID, "channel_id", "timestamp")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), channelID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timestamp"), timestamp)...)
+}
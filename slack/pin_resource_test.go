@@ -0,0 +1,56 @@
+package slack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestIsPinned(t *testing.T) {
+	items := []slack.Item{
+		slack.NewFileItem(&slack.File{ID: "F1"}),
+		slack.NewMessageItem("C1", &slack.Message{Msg: slack.Msg{Timestamp: "1700000000.000100"}}),
+	}
+
+	if !isPinned(items, "1700000000.000100") {
+		t.Error("expected the pinned message to be found")
+	}
+	if isPinned(items, "1700000000.000200") {
+		t.Error("expected an unpinned message not to be found")
+	}
+}
+
+func TestAccPinResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "slack_message" "test" {
+	channel_id = "%s"
+	text       = "Pinned by Terraform"
+}
+
+resource "slack_pin" "test" {
+	channel_id = slack_message.test.channel_id
+	timestamp  = slack_message.test.id
+}
+`, slackTestConversationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("slack_pin.test", "timestamp", "slack_message.test", "id"),
+					resource.TestCheckResourceAttr("slack_pin.test", "channel_id", slackTestConversationID),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "slack_pin.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -2,22 +2,33 @@ package slack
 
 import (
 	"context"
+	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/slack-go/slack"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ provider.Provider = &slackProvider{}
+	_ provider.Provider                       = &slackProvider{}
+	_ provider.ProviderWithConfigValidators   = &slackProvider{}
+	_ provider.ProviderWithEphemeralResources = &slackProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -30,7 +41,19 @@ type slackProvider struct{}
 
 // slackProviderModel maps provider schema data to a Go type.
 type slackProviderModel struct {
-	Token types.String `tfsdk:"token"`
+	Token        types.String `tfsdk:"token"`
+	TokenFile    types.String `tfsdk:"token_file"`
+	TokenCommand types.String `tfsdk:"token_command"`
+	BotToken     types.String `tfsdk:"bot_token"`
+	UserToken    types.String `tfsdk:"user_token"`
+	AdminToken   types.String `tfsdk:"admin_token"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	RefreshToken types.String `tfsdk:"refresh_token"`
+	APIURL       types.String `tfsdk:"api_url"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait types.Int64  `tfsdk:"retry_max_wait"`
+	ReadCache    types.Bool   `tfsdk:"read_cache"`
 }
 
 // Metadata returns the provider type name.
@@ -38,13 +61,109 @@ func (p *slackProvider) Metadata(_ context.Context, _ provider.MetadataRequest,
 	resp.TypeName = "slack"
 }
 
+// ConfigValidators ensures that at most one source of the generic token is
+// configured. Configure ensures that some token is set once the environment
+// variables are read, as validators only see the configuration.
+func (p *slackProvider) ConfigValidators(_ context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providervalidator.Conflicting(
+			path.MatchRoot("token"),
+			path.MatchRoot("token_file"),
+			path.MatchRoot("token_command"),
+			path.MatchRoot("refresh_token"),
+		),
+	}
+}
+
 // Schema defines the provider-level schema for configuration data.
 func (p *slackProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"token": schema.StringAttribute{
-				Required:    true,
-				Description: "A valid token for the Slack API. May also be provided via SLACK_TOKEN environment variable.",
+				Optional:  true,
+				Sensitive: true,
+				Description: "A valid token for the Slack API, used for every call whose specific token type is not configured. " +
+					"May also be provided via SLACK_TOKEN environment variable. Conflicts with token_file, token_command and refresh_token. " +
+					"Unless bot_token, user_token or admin_token is set, exactly one of token, token_file, token_command and refresh_token, " +
+					"or their environment variables, must provide a token.",
+			},
+			"token_file": schema.StringAttribute{
+				Optional: true,
+				Description: "The path to a file containing the token, used in place of token. Surrounding whitespace is ignored. " +
+					"Conflicts with token, token_command and refresh_token.",
+			},
+			"token_command": schema.StringAttribute{
+				Optional: true,
+				Description: "A command run through the system shell whose standard output is the token, used in place of token, " +
+					"for example to read it from a password manager. Surrounding whitespace is ignored. " +
+					"Conflicts with token, token_file and refresh_token.",
+			},
+			"bot_token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "A bot token (xoxb-), used by data sources and resources that run as the app's bot. " +
+					"Defaults to token. May also be provided via SLACK_BOT_TOKEN environment variable.",
+			},
+			"user_token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "A user token (xoxp-), used by resources whose Slack API methods only accept user tokens, such as user group writes. " +
+					"Defaults to token. May also be provided via SLACK_USER_TOKEN environment variable.",
+			},
+			"admin_token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "A user token of a workspace or org admin, used by resources calling admin-only Slack API methods. " +
+					"Defaults to token. May also be provided via SLACK_ADMIN_TOKEN environment variable.",
+			},
+			"client_id": schema.StringAttribute{
+				Optional: true,
+				Description: "The client ID of a Slack app with token rotation enabled, used with client_secret to exchange refresh_token " +
+					"for access tokens. May also be provided via SLACK_CLIENT_ID environment variable.",
+			},
+			"client_secret": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The client secret of the Slack app. May also be provided via SLACK_CLIENT_SECRET environment variable.",
+			},
+			"refresh_token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "A refresh token (xoxe-) of a Slack app with token rotation enabled. It is exchanged through oauth.v2.access for " +
+					"an access token used in place of token, which is refreshed whenever Slack reports it expired. " +
+					"Conflicts with token, token_file and token_command. May also be provided via SLACK_REFRESH_TOKEN environment variable, " +
+					"which is ignored when token, token_file or token_command is set.",
+			},
+			"api_url": schema.StringAttribute{
+				Optional: true,
+				Description: "The base URL of the Slack Web API, for example an internal proxy or a local mock server. " +
+					"Defaults to " + slack.APIURL + ". May also be provided via SLACK_API_URL environment variable.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Description: "The number of times a Slack API call is retried after being rate limited or failing with a server or network error. " +
+					"Calls that change something, such as posting a message, are only retried when rate limited or when they could not be sent, " +
+					"as Slack may have applied them despite the error. Defaults to " + strconv.Itoa(defaultMaxRetries) + ". Set to 0 to disable retries.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Optional: true,
+				Description: "The longest time, in seconds, to wait before retrying a Slack API call. Rate limited calls whose Retry-After " +
+					"exceeds this are not retried. Defaults to " + strconv.Itoa(int(defaultRetryMaxWait.Seconds())) + ".",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"read_cache": schema.BoolAttribute{
+				Optional: true,
+				Description: "When true, users and public and private channels are loaded in bulk once per run, and the slack_user and " +
+					"slack_conversation data sources are served from memory. Speeds up configurations with many of those data sources, " +
+					"at the cost of data sources not seeing changes made earlier in the same run. The slack_users data source and user filters " +
+					"share the cached user list. conversations.list does not report a channel's locale, last_read and unread counts, so " +
+					"slack_conversation leaves them empty for cached channels. When a bulk load fails, for example for lack of a scope, " +
+					"lookups fall back to fetching each item. Defaults to false.",
 			},
 		},
 		Blocks:      map[string]schema.Block{},
@@ -78,6 +197,114 @@ func (p *slackProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		)
 	}
 
+	if config.TokenFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_file"),
+			"Unknown Slack API Token File",
+			"The provider cannot create the Slack API client as there is an unknown configuration value for the Slack API token file. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.TokenCommand.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_command"),
+			"Unknown Slack API Token Command",
+			"The provider cannot create the Slack API client as there is an unknown configuration value for the Slack API token command. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.BotToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("bot_token"),
+			"Unknown Slack API Bot Token",
+			"The provider cannot create the Slack API client as there is an unknown configuration value for the Slack API bot token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SLACK_BOT_TOKEN environment variable.",
+		)
+	}
+
+	if config.UserToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_token"),
+			"Unknown Slack API User Token",
+			"The provider cannot create the Slack API client as there is an unknown configuration value for the Slack API user token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SLACK_USER_TOKEN environment variable.",
+		)
+	}
+
+	if config.AdminToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("admin_token"),
+			"Unknown Slack API Admin Token",
+			"The provider cannot create the Slack API client as there is an unknown configuration value for the Slack API admin token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SLACK_ADMIN_TOKEN environment variable.",
+		)
+	}
+
+	if config.ClientID.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_id"),
+			"Unknown Slack App Client ID",
+			"The provider cannot create the Slack API client as there is an unknown configuration value for the Slack app client ID. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SLACK_CLIENT_ID environment variable.",
+		)
+	}
+
+	if config.ClientSecret.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret"),
+			"Unknown Slack App Client Secret",
+			"The provider cannot create the Slack API client as there is an unknown configuration value for the Slack app client secret. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SLACK_CLIENT_SECRET environment variable.",
+		)
+	}
+
+	if config.RefreshToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("refresh_token"),
+			"Unknown Slack API Refresh Token",
+			"The provider cannot create the Slack API client as there is an unknown configuration value for the Slack API refresh token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SLACK_REFRESH_TOKEN environment variable.",
+		)
+	}
+
+	if config.APIURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_url"),
+			"Unknown Slack API URL",
+			"The provider cannot create the Slack API client as there is an unknown configuration value for the Slack API URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SLACK_API_URL environment variable.",
+		)
+	}
+
+	if config.MaxRetries.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Unknown Slack API Maximum Retries",
+			"The provider cannot create the Slack API client as there is an unknown configuration value for max_retries. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.RetryMaxWait.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"Unknown Slack API Retry Maximum Wait",
+			"The provider cannot create the Slack API client as there is an unknown configuration value for retry_max_wait. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.ReadCache.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_cache"),
+			"Unknown Slack API Read Cache Setting",
+			"The provider cannot create the Slack API client as there is an unknown configuration value for read_cache. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -86,30 +313,150 @@ func (p *slackProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	// with Terraform configuration value if set.
 
 	token := os.Getenv("SLACK_TOKEN")
+	botToken := os.Getenv("SLACK_BOT_TOKEN")
+	userToken := os.Getenv("SLACK_USER_TOKEN")
+	adminToken := os.Getenv("SLACK_ADMIN_TOKEN")
+	clientID := os.Getenv("SLACK_CLIENT_ID")
+	clientSecret := os.Getenv("SLACK_CLIENT_SECRET")
+	refreshToken := os.Getenv("SLACK_REFRESH_TOKEN")
+	apiURL := os.Getenv("SLACK_API_URL")
+
+	// A generic token source set in the configuration overrides those set
+	// through the environment, so that a stray SLACK_REFRESH_TOKEN does not
+	// switch an explicitly configured token to token rotation.
+	switch {
+	case !config.Token.IsNull() || !config.TokenFile.IsNull() || !config.TokenCommand.IsNull():
+		clientID, clientSecret, refreshToken = "", "", ""
+	case !config.RefreshToken.IsNull():
+		token = ""
+	case token != "" && refreshToken != "":
+		resp.Diagnostics.AddError(
+			"Conflicting Slack API Token Sources",
+			"The provider cannot tell which Slack API token to use as both the SLACK_TOKEN and SLACK_REFRESH_TOKEN environment variables are set. "+
+				"Unset one of them, or set the token source in the configuration.",
+		)
+		return
+	}
 
 	if !config.Token.IsNull() {
 		token = config.Token.ValueString()
 	}
+
+	// Mask sensitive value in logs
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "slack_token")
+
+	if !config.TokenFile.IsNull() {
+		fileToken, err := readTokenFile(config.TokenFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_file"),
+				"Unable to Read Slack API Token File",
+				"The provider could not read the Slack API token from token_file.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+		token = fileToken
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, token)
+		tflog.Debug(ctx, "Read Slack API token from file", map[string]any{"token_file": config.TokenFile.ValueString()})
+	}
+
+	if !config.TokenCommand.IsNull() {
+		commandToken, err := runTokenCommand(ctx, config.TokenCommand.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_command"),
+				"Unable to Run Slack API Token Command",
+				"The provider could not obtain the Slack API token from token_command.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+		token = commandToken
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, token)
+		tflog.Debug(ctx, "Read Slack API token from command", map[string]any{"token_command": config.TokenCommand.ValueString()})
+	}
+
+	if !config.BotToken.IsNull() {
+		botToken = config.BotToken.ValueString()
+	}
+
+	if !config.UserToken.IsNull() {
+		userToken = config.UserToken.ValueString()
+	}
+
+	if !config.AdminToken.IsNull() {
+		adminToken = config.AdminToken.ValueString()
+	}
+
+	if !config.ClientID.IsNull() {
+		clientID = config.ClientID.ValueString()
+	}
+
+	if !config.ClientSecret.IsNull() {
+		clientSecret = config.ClientSecret.ValueString()
+	}
+
+	if !config.RefreshToken.IsNull() {
+		refreshToken = config.RefreshToken.ValueString()
+	}
+
+	if !config.APIURL.IsNull() {
+		apiURL = config.APIURL.ValueString()
+	}
+
+	maxRetries := defaultMaxRetries
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	retryMaxWait := defaultRetryMaxWait
+	if !config.RetryMaxWait.IsNull() {
+		retryMaxWait = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
-	if token == "" {
-		resp.Diagnostics.AddAttributeWarning(
+	if token == "" && refreshToken == "" && botToken == "" && userToken == "" && adminToken == "" {
+		resp.Diagnostics.AddAttributeError(
 			path.Root("token"),
 			"Missing Slack API Token",
 			"The provider cannot create the Slack API client as there is a missing or empty value for the Slack API token. "+
-				"Set the token value in the configuration or use the SLACK_TOKEN environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set one of token, token_file, token_command or refresh_token, or any of bot_token, user_token and admin_token, "+
+				"in the configuration, or use the SLACK_TOKEN, SLACK_REFRESH_TOKEN, SLACK_BOT_TOKEN, SLACK_USER_TOKEN or SLACK_ADMIN_TOKEN "+
+				"environment variable. If either is already set, ensure the value is not empty.",
+		)
+	}
+
+	if (clientID != "" || clientSecret != "" || refreshToken != "") && (clientID == "" || clientSecret == "" || refreshToken == "") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("refresh_token"),
+			"Incomplete Slack Token Rotation Configuration",
+			"The provider cannot refresh Slack access tokens unless client_id, client_secret and refresh_token are all set. "+
+				"Set them in the configuration or use the SLACK_CLIENT_ID, SLACK_CLIENT_SECRET and SLACK_REFRESH_TOKEN environment variables.",
 		)
 	}
 
+	if apiURL != "" {
+		u, err := url.Parse(apiURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_url"),
+				"Invalid Slack API URL",
+				"The Slack API URL must be an absolute http or https URL, such as "+slack.APIURL+", but got: "+apiURL,
+			)
+		}
+		// The Slack client appends method names directly to the base URL.
+		if !strings.HasSuffix(apiURL, "/") {
+			apiURL += "/"
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Mask sensitive value in logs
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "slack_token")
-
 	tflog.Debug(ctx, "Creating Slack client")
 
 	// Enable debugging in the Slack client, if it is enabled for Terraform
@@ -119,26 +466,105 @@ func (p *slackProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		debug = true
 	}
 
-	// Instantiate the client that we will use to talk to the Slack server
-	api := slack.New(token, slack.OptionDebug(debug))
-	// Test that we have some basic connectivity
-	params := slack.NewListReactionsParameters()
-	params.Count = int(1)
-	_, _, err := api.ListReactions(params)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Create Slack API Client",
-			"An unexpected error occurred when creating the Slack API client. "+
-				"If the error is not clear, please contact the provider developers.\n\n"+
-				"Slack Client Error: "+err.Error(),
-		)
+	// Keep every data source and resource sharing this client within each
+	// method's rate limit tier, and retry rate limited and transiently
+	// failing calls
+	clock := realClock{}
+	throttledClient := newThrottledHTTPClient(ctx, &http.Client{}, newThrottler(clock))
+	httpClient := newRetryingHTTPClient(ctx, throttledClient, clock, maxRetries, retryMaxWait)
+
+	if apiURL != "" {
+		tflog.Debug(ctx, "Using custom Slack API URL", map[string]any{"api_url": apiURL})
+	} else {
+		apiURL = slack.APIURL
+	}
+
+	// Exchange the refresh token for an access token, which then stands in
+	// as the generic token and is refreshed whenever it expires
+	var rotatingClient *rotatingTokenHTTPClient
+	if refreshToken != "" {
+		var err error
+		rotatingClient, err = newRotatingTokenHTTPClient(ctx, httpClient, apiURL, clientID, clientSecret, refreshToken)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("refresh_token"),
+				"Unable to Refresh Slack Access Token",
+				"The provider could not exchange the refresh token for an access token through oauth.v2.access. "+
+					"Check client_id, client_secret and refresh_token.\n\n"+
+					"Slack Client Error: "+err.Error(),
+			)
+			return
+		}
+		token = rotatingClient.token()
+	}
+
+	// The generic token stands in for every token type not configured
+	// explicitly.
+	if botToken == "" {
+		botToken = token
+	}
+	if userToken == "" {
+		userToken = token
+	}
+	if adminToken == "" {
+		adminToken = token
+	}
+
+	// Instantiate a client for each distinct token that we will use to
+	// talk to the Slack server
+	byToken := map[string]*slackClient{}
+	newClient := func(tokenType tokenType, token string) *slackClient {
+		if token == "" {
+			return nil
+		}
+		if client, ok := byToken[token]; ok {
+			return client
+		}
+
+		var doer httpDoer = httpClient
+		if rotatingClient != nil && token == rotatingClient.token() {
+			doer = rotatingClient
+		}
+
+		// Test that we have some basic connectivity and learn who we are
+		client, err := newSlackClient(token, doer, apiURL, slack.OptionDebug(debug))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Slack API Client",
+				"An unexpected error occurred when creating the Slack API client for the "+string(tokenType)+" token. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"Slack Client Error: "+err.Error(),
+			)
+			return nil
+		}
+
+		if config.ReadCache.ValueBool() {
+			tflog.Debug(ctx, "Enabling Slack read cache", map[string]any{"token_type": string(tokenType)})
+			client.cache = newReadCache()
+		}
+
+		tflog.Debug(ctx, "Authenticated Slack client", map[string]any{"token_type": string(tokenType), "team_id": client.TeamID, "user_id": client.UserID, "scopes": client.grantedScopes()})
+		byToken[token] = client
+		return client
+	}
+
+	clients := &slackClients{
+		bot:   newClient(tokenTypeBot, botToken),
+		user:  newClient(tokenTypeUser, userToken),
+		admin: newClient(tokenTypeAdmin, adminToken),
+
+		httpClient: httpClient,
+		apiURL:     apiURL,
+	}
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Make the Slack client available during DataSource and Resource
+	// Make the Slack clients available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = api
-	resp.ResourceData = api
+	resp.DataSourceData = clients
+	resp.ResourceData = clients
+	resp.EphemeralResourceData = clients
 
 	tflog.Info(ctx, "Configured Slack client", map[string]any{"success": true})
 }
@@ -146,15 +572,36 @@ func (p *slackProvider) Configure(ctx context.Context, req provider.ConfigureReq
 // DataSources defines the data sources implemented in the provider.
 func (p *slackProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAuthDataSource,
 		NewUserDataSource,
+		NewUsersDataSource,
 		NewConversationDataSource,
+		NewConversationsDataSource,
+		NewEmojiListDataSource,
+		NewUsergroupDataSource,
 	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *slackProvider) Resources(_ context.Context) []func() resource.Resource {
-	//	return []func() resource.Resource{
-	//		NewSlackResource,
-	//	}
-	return nil
+	return []func() resource.Resource{
+		NewBookmarkResource,
+		NewConversationResource,
+		NewConversationMembersResource,
+		NewConversationPurposeResource,
+		NewConversationTopicResource,
+		NewEmojiResource,
+		NewMessageResource,
+		NewPinResource,
+		NewUsergroupResource,
+		NewUsergroupMembersResource,
+		NewUserProfileResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *slackProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewOAuthTokenEphemeralResource,
+	}
 }
@@ -0,0 +1,194 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testProviderConfigure runs the provider Configure method with the given
+// attribute values, leaving every other attribute null.
+func testProviderConfigure(t *testing.T, config map[string]tftypes.Value) *provider.ConfigureResponse {
+	t.Helper()
+	ctx := context.Background()
+	p := New()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("provider schema is not an object")
+	}
+
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := config[name]; ok {
+			values[name] = value
+		} else {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, values),
+		},
+	}, resp)
+
+	return resp
+}
+
+func TestProviderConfigureAPIURL(t *testing.T) {
+	t.Setenv("SLACK_API_URL", "")
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"auth.test": func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("X-OAuth-Scopes", "channels:read,users:read")
+			fmt.Fprint(w, testIdentityResponse)
+		},
+	})
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"token":   tftypes.NewValue(tftypes.String, "xoxb-test"),
+		"api_url": tftypes.NewValue(tftypes.String, server.URL+"/api"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	requests := server.requestsTo("")
+	if len(requests) == 0 {
+		t.Fatal("expected the provider to call the stand-in server")
+	}
+	if requests[0].path != "/api/auth.test" {
+		t.Errorf("expected a call to /api/auth.test, got %s", requests[0].path)
+	}
+
+	clients, ok := resp.ResourceData.(*slackClients)
+	if !ok {
+		t.Fatalf("expected resource data to be a *slackClients, got %T", resp.ResourceData)
+	}
+	client := clients.bot
+	if client.TeamID != "T0TEST" || client.UserID != "U0TEST" {
+		t.Errorf("expected the auth.test identity to be recorded, got team %q user %q", client.TeamID, client.UserID)
+	}
+	if scopes := client.scopes.granted(); !scopes["channels:read"] || !scopes["users:read"] || len(scopes) != 2 {
+		t.Errorf("expected the granted scopes to be recorded, got %v", scopes)
+	}
+}
+
+func TestProviderConfigureAPIURLFromEnv(t *testing.T) {
+	server := newTestServer(t, nil)
+	t.Setenv("SLACK_API_URL", server.apiURL())
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"token": tftypes.NewValue(tftypes.String, "xoxb-test"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if len(server.requestsTo("")) == 0 {
+		t.Fatal("expected the provider to call the stand-in server")
+	}
+}
+
+func TestProviderConfigureInvalidAPIURL(t *testing.T) {
+	t.Setenv("SLACK_API_URL", "")
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"token":   tftypes.NewValue(tftypes.String, "xoxb-test"),
+		"api_url": tftypes.NewValue(tftypes.String, "slack.example.com"),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a relative API URL")
+	}
+}
+
+// testClearTokenEnv unsets the token and token rotation environment
+// variables for the test.
+func testClearTokenEnv(t *testing.T) {
+	t.Helper()
+	for _, name := range []string{
+		"SLACK_TOKEN", "SLACK_BOT_TOKEN", "SLACK_USER_TOKEN", "SLACK_ADMIN_TOKEN",
+		"SLACK_CLIENT_ID", "SLACK_CLIENT_SECRET", "SLACK_REFRESH_TOKEN",
+	} {
+		t.Setenv(name, "")
+	}
+}
+
+func TestProviderConfigureTokenTypes(t *testing.T) {
+	testClearTokenEnv(t)
+	server := newTestServer(t, nil)
+	t.Setenv("SLACK_API_URL", server.apiURL())
+	t.Setenv("SLACK_USER_TOKEN", "xoxp-user")
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"token": tftypes.NewValue(tftypes.String, "xoxb-test"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	clients := resp.DataSourceData.(*slackClients)
+	if clients.bot == nil || clients.user == nil || clients.admin == nil {
+		t.Fatalf("expected a client for every token type, got %+v", clients)
+	}
+	if clients.bot != clients.admin {
+		t.Error("expected the bot and admin token types to share the generic token's client")
+	}
+	if clients.bot == clients.user {
+		t.Error("expected the user token type to have its own client")
+	}
+	if requests := server.requestsTo("auth.test"); len(requests) != 2 {
+		t.Errorf("expected auth.test to be called once per distinct token, got %v", requests)
+	}
+}
+
+func TestProviderConfigureMissingTokenType(t *testing.T) {
+	testClearTokenEnv(t)
+	server := newTestServer(t, nil)
+	t.Setenv("SLACK_API_URL", server.apiURL())
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"bot_token": tftypes.NewValue(tftypes.String, "xoxb-test"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	clients := resp.ResourceData.(*slackClients)
+	var diags diag.Diagnostics
+	if client := clients.client(tokenTypeBot, &diags); client == nil || diags.HasError() {
+		t.Fatalf("expected a bot client, got diagnostics: %v", diags)
+	}
+	if client := clients.client(tokenTypeAdmin, &diags); client != nil || !diags.HasError() {
+		t.Fatal("expected an error for the missing admin token")
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "admin_token") || !strings.Contains(detail, "SLACK_ADMIN_TOKEN") {
+		t.Errorf("expected the error to name the admin token settings, got %q", detail)
+	}
+}
+
+func TestProviderConfigureMissingToken(t *testing.T) {
+	testClearTokenEnv(t)
+	server := newTestServer(t, nil)
+	t.Setenv("SLACK_API_URL", server.apiURL())
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when no token is set")
+	}
+	if len(server.requestsTo("")) != 0 {
+		t.Errorf("expected no call to Slack without a token, got %v", server.requestsTo(""))
+	}
+}
@@ -0,0 +1,258 @@
+package slack
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/slack-go/slack"
+	"golang.org/x/sync/singleflight"
+)
+
+// readCache holds users and conversations bulk loaded from users.list and
+// conversations.list, so that data sources looking up many of them make a
+// handful of calls per run instead of one each. Items missing from the bulk
+// load are fetched individually and added, as are all items when the bulk
+// load fails, for example for lack of a scope.
+type readCache struct {
+	// group de-duplicates concurrent loads and lookups of the same key.
+	group singleflight.Group
+
+	mu                  sync.RWMutex
+	userList            []slack.User
+	users               map[string]*slack.User
+	usersByEmail        map[string]*slack.User
+	conversationList    []slack.Channel
+	conversations       map[string]*slack.Channel
+	usersLoaded         bool
+	conversationsLoaded bool
+
+	// usersErr and conversationsErr hold why the bulk load failed, so that
+	// it is only attempted once.
+	usersErr         error
+	conversationsErr error
+}
+
+// newReadCache returns an empty read cache.
+func newReadCache() *readCache {
+	return &readCache{
+		users:         map[string]*slack.User{},
+		usersByEmail:  map[string]*slack.User{},
+		conversations: map[string]*slack.Channel{},
+	}
+}
+
+// addUser indexes a user by ID and email address. The caller must hold mu.
+func (c *readCache) addUser(user *slack.User) {
+	c.users[user.ID] = user
+	if user.Profile.Email != "" {
+		c.usersByEmail[strings.ToLower(user.Profile.Email)] = user
+	}
+}
+
+// loadUsers bulk loads users.list, once, and returns why it failed.
+func (c *slackClient) loadUsers() error {
+	c.cache.mu.RLock()
+	loaded, loadErr := c.cache.usersLoaded, c.cache.usersErr
+	c.cache.mu.RUnlock()
+	if loaded {
+		return loadErr
+	}
+
+	_, err, _ := c.cache.group.Do("users.list", func() (any, error) {
+		users, err := c.GetUsers()
+
+		c.cache.mu.Lock()
+		defer c.cache.mu.Unlock()
+		if err != nil {
+			c.cache.usersLoaded, c.cache.usersErr = true, err
+			return nil, err
+		}
+		c.cache.userList = users
+		for i := range users {
+			c.cache.addUser(&users[i])
+		}
+		c.cache.usersLoaded = true
+		return nil, nil
+	})
+	return err
+}
+
+// loadConversations bulk loads every public and private channel, archived
+// or not, from conversations.list, once, and returns why it failed.
+func (c *slackClient) loadConversations() error {
+	c.cache.mu.RLock()
+	loaded, loadErr := c.cache.conversationsLoaded, c.cache.conversationsErr
+	c.cache.mu.RUnlock()
+	if loaded {
+		return loadErr
+	}
+
+	_, err, _ := c.cache.group.Do("conversations.list", func() (any, error) {
+		conversations, err := getAllConversations(c, []string{"public_channel", "private_channel"}, false)
+
+		c.cache.mu.Lock()
+		defer c.cache.mu.Unlock()
+		if err != nil {
+			c.cache.conversationsLoaded, c.cache.conversationsErr = true, err
+			return nil, err
+		}
+		c.cache.conversationList = conversations
+		for i := range conversations {
+			c.cache.conversations[conversations[i].ID] = &conversations[i]
+		}
+		c.cache.conversationsLoaded = true
+		return nil, nil
+	})
+	return err
+}
+
+// getUser returns the user with the given ID, from the read cache when it
+// is enabled.
+func (c *slackClient) getUser(id string) (*slack.User, error) {
+	if c.cache == nil {
+		return c.GetUserInfo(id)
+	}
+
+	// A failed bulk load leaves the lookup to users.info, as without the cache.
+	_ = c.loadUsers()
+
+	c.cache.mu.RLock()
+	user, ok := c.cache.users[id]
+	c.cache.mu.RUnlock()
+	if ok {
+		return user, nil
+	}
+
+	result, err, _ := c.cache.group.Do("users.info/"+id, func() (any, error) {
+		user, err := c.GetUserInfo(id)
+		if err != nil {
+			return nil, err
+		}
+
+		c.cache.mu.Lock()
+		defer c.cache.mu.Unlock()
+		c.cache.addUser(user)
+		return user, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*slack.User), nil
+}
+
+// getUserByEmail returns the user with the given email address, from the
+// read cache when it is enabled.
+func (c *slackClient) getUserByEmail(email string) (*slack.User, error) {
+	if c.cache == nil {
+		return c.GetUserByEmail(email)
+	}
+
+	// A failed bulk load leaves the lookup to users.lookupByEmail, as without
+	// the cache.
+	_ = c.loadUsers()
+
+	key := strings.ToLower(email)
+	c.cache.mu.RLock()
+	user, ok := c.cache.usersByEmail[key]
+	c.cache.mu.RUnlock()
+	if ok {
+		return user, nil
+	}
+
+	result, err, _ := c.cache.group.Do("users.lookupByEmail/"+key, func() (any, error) {
+		user, err := c.GetUserByEmail(email)
+		if err != nil {
+			return nil, err
+		}
+
+		c.cache.mu.Lock()
+		defer c.cache.mu.Unlock()
+		c.cache.addUser(user)
+		return user, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*slack.User), nil
+}
+
+// listUsers returns every user in the workspace, from the read cache when
+// it is enabled.
+func (c *slackClient) listUsers() ([]slack.User, error) {
+	if c.cache == nil {
+		return c.GetUsers()
+	}
+
+	if err := c.loadUsers(); err != nil {
+		return nil, err
+	}
+
+	c.cache.mu.RLock()
+	defer c.cache.mu.RUnlock()
+	return c.cache.userList, nil
+}
+
+// getConversation returns the conversation with the given ID, from the read
+// cache when it is enabled.
+func (c *slackClient) getConversation(id string) (*slack.Channel, error) {
+	input := slack.GetConversationInfoInput{
+		ChannelID:         id,
+		IncludeLocale:     true,
+		IncludeNumMembers: true,
+	}
+
+	if c.cache == nil {
+		return c.GetConversationInfo(&input)
+	}
+
+	// A failed bulk load leaves the lookup to conversations.info, as without
+	// the cache.
+	_ = c.loadConversations()
+
+	c.cache.mu.RLock()
+	conversation, ok := c.cache.conversations[id]
+	c.cache.mu.RUnlock()
+	if ok {
+		return conversation, nil
+	}
+
+	result, err, _ := c.cache.group.Do("conversations.info/"+id, func() (any, error) {
+		conversation, err := c.GetConversationInfo(&input)
+		if err != nil {
+			return nil, err
+		}
+
+		c.cache.mu.Lock()
+		defer c.cache.mu.Unlock()
+		c.cache.conversations[id] = conversation
+		return conversation, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*slack.Channel), nil
+}
+
+// listChannels returns every public and private channel, from the read
+// cache when it is enabled.
+func (c *slackClient) listChannels(excludeArchived bool) ([]slack.Channel, error) {
+	if c.cache == nil {
+		return getAllConversations(c, []string{"public_channel", "private_channel"}, excludeArchived)
+	}
+
+	if err := c.loadConversations(); err != nil {
+		return nil, err
+	}
+
+	c.cache.mu.RLock()
+	defer c.cache.mu.RUnlock()
+
+	channels := make([]slack.Channel, 0, len(c.cache.conversationList))
+	for _, conversation := range c.cache.conversationList {
+		if excludeArchived && conversation.IsArchived {
+			continue
+		}
+		channels = append(channels, conversation)
+	}
+	return channels, nil
+}
@@ -0,0 +1,188 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+// testReadCacheServer starts a testServer with one listed user and two
+// listed channels. users.info and conversations.info answer for any ID.
+func testReadCacheServer(t *testing.T) *testServer {
+	t.Helper()
+	return newTestServer(t, map[string]http.HandlerFunc{
+		"users.list": func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"ok":true,"members":[{"id":"U1","name":"listed","profile":{"email":"listed@example.com"}}]}`)
+		},
+		"users.info": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"ok":true,"user":{"id":%q,"name":"fetched"}}`, r.FormValue("user"))
+		},
+		"conversations.list": func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"ok":true,"channels":[{"id":"C1","name":"active"},{"id":"C2","name":"old","is_archived":true}]}`)
+		},
+		"conversations.info": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"ok":true,"channel":{"id":%q,"name":"fetched"}}`, r.FormValue("channel"))
+		},
+	})
+}
+
+func TestReadCacheUsers(t *testing.T) {
+	server := testReadCacheServer(t)
+	client := &slackClient{
+		Client: slack.New("xoxb-test", slack.OptionAPIURL(server.apiURL())),
+		cache:  newReadCache(),
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 30)
+	for i := 0; i < 10; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			if user, err := client.getUser("U1"); err != nil || user.Name != "listed" {
+				errs <- fmt.Errorf("expected the listed user, got %v, %v", user, err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if user, err := client.getUser("U2"); err != nil || user.Name != "fetched" {
+				errs <- fmt.Errorf("expected the fetched user, got %v, %v", user, err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if user, err := client.getUserByEmail("Listed@example.com"); err != nil || user.ID != "U1" {
+				errs <- fmt.Errorf("expected the listed user by email, got %v, %v", user, err)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	if n := len(server.requestsTo("users.list")); n != 1 {
+		t.Errorf("expected users.list to be called once, got %d", n)
+	}
+	if n := len(server.requestsTo("users.info")); n != 1 {
+		t.Errorf("expected users.info to be called once for the missing user, got %d", n)
+	}
+	if n := len(server.requestsTo("users.lookupByEmail")); n != 0 {
+		t.Errorf("expected users.lookupByEmail not to be called, got %d", n)
+	}
+}
+
+func TestReadCacheConversations(t *testing.T) {
+	server := testReadCacheServer(t)
+	client := &slackClient{
+		Client: slack.New("xoxb-test", slack.OptionAPIURL(server.apiURL())),
+		cache:  newReadCache(),
+	}
+
+	for _, id := range []string{"C1", "C2", "C3", "C3"} {
+		if _, err := client.getConversation(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	channels, err := client.listChannels(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(channels) != 1 || channels[0].ID != "C1" {
+		t.Errorf("expected only the active channel, got %v", channels)
+	}
+
+	if n := len(server.requestsTo("conversations.list")); n != 1 {
+		t.Errorf("expected conversations.list to be called once, got %d", n)
+	}
+	if n := len(server.requestsTo("conversations.info")); n != 1 {
+		t.Errorf("expected conversations.info to be called once for the missing channel, got %d", n)
+	}
+}
+
+func TestReadCacheDisabled(t *testing.T) {
+	server := testReadCacheServer(t)
+	client := &slackClient{
+		Client: slack.New("xoxb-test", slack.OptionAPIURL(server.apiURL())),
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.getUser("U1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := len(server.requestsTo("users.list")); n != 0 {
+		t.Errorf("expected users.list not to be called, got %d", n)
+	}
+	if n := len(server.requestsTo("users.info")); n != 3 {
+		t.Errorf("expected users.info to be called for every lookup, got %d", n)
+	}
+}
+
+func TestReadCacheFailedLoad(t *testing.T) {
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"users.list": func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"ok":false,"error":"missing_scope"}`)
+		},
+		"users.info": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"ok":true,"user":{"id":%q,"name":"fetched"}}`, r.FormValue("user"))
+		},
+		"conversations.list": func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"ok":false,"error":"missing_scope"}`)
+		},
+		"conversations.info": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"ok":true,"channel":{"id":%q,"name":"fetched"}}`, r.FormValue("channel"))
+		},
+	})
+	client := &slackClient{
+		Client: slack.New("xoxb-test", slack.OptionAPIURL(server.apiURL())),
+		cache:  newReadCache(),
+	}
+
+	for i := 0; i < 2; i++ {
+		if user, err := client.getUser("U1"); err != nil || user.Name != "fetched" {
+			t.Fatalf("expected the fetched user, got %v, %v", user, err)
+		}
+		if conversation, err := client.getConversation("C1"); err != nil || conversation.Name != "fetched" {
+			t.Fatalf("expected the fetched conversation, got %v, %v", conversation, err)
+		}
+	}
+	if _, err := client.listUsers(); err == nil {
+		t.Error("expected listing users to report the failed bulk load")
+	}
+
+	for _, method := range []string{"users.list", "users.info", "conversations.list", "conversations.info"} {
+		if n := len(server.requestsTo(method)); n != 1 {
+			t.Errorf("expected %s to be called once, got %d", method, n)
+		}
+	}
+}
+
+func TestReadCacheFilteredUsers(t *testing.T) {
+	server := testReadCacheServer(t)
+	client := &slackClient{
+		Client: slack.New("xoxb-test", slack.OptionAPIURL(server.apiURL())),
+		cache:  newReadCache(),
+	}
+
+	for i := 0; i < 2; i++ {
+		users, diags := getFilteredUsers(context.Background(), client, nil)
+		if diags.HasError() || len(users) != 1 {
+			t.Fatalf("expected the listed user, got %v, %v", users, diags)
+		}
+	}
+	if _, err := client.getUser("U1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := len(server.requestsTo("users.list")); n != 1 {
+		t.Errorf("expected users.list to be called once, got %d", n)
+	}
+}
@@ -0,0 +1,161 @@
+package slack
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"net/http/httptrace"
+	"path"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultMaxRetries is the number of times a request is retried when
+	// max_retries is not configured.
+	defaultMaxRetries = 3
+
+	// defaultRetryMaxWait is the longest single wait between attempts when
+	// retry_max_wait is not configured.
+	defaultRetryMaxWait = 60 * time.Second
+
+	// retryBaseWait is the wait before the first retry of a transient
+	// failure; it doubles with every further attempt.
+	retryBaseWait = time.Second
+)
+
+// retryingHTTPClient is the HTTP client handed to the Slack API client. It
+// retries rate limited requests once Slack's Retry-After period has passed,
+// and retries server errors and network failures with jittered exponential
+// backoff. Writes, which Slack may have applied despite failing, are only
+// retried when rate limited or when the request was never sent.
+type retryingHTTPClient struct {
+	client     httpDoer
+	clock      clock
+	maxRetries int
+	maxWait    time.Duration
+
+	// logCtx carries the provider logger, since the Slack client issues
+	// requests without a Terraform context.
+	logCtx context.Context
+}
+
+// httpDoer is the interface the Slack API client expects of its HTTP client.
+type httpDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// newRetryingHTTPClient wraps client with the provider's retry policy.
+func newRetryingHTTPClient(ctx context.Context, client httpDoer, clock clock, maxRetries int, maxWait time.Duration) *retryingHTTPClient {
+	return &retryingHTTPClient{
+		client:     client,
+		clock:      clock,
+		maxRetries: maxRetries,
+		maxWait:    maxWait,
+		logCtx:     ctx,
+	}
+}
+
+// Do sends the request, retrying it according to the retry policy.
+func (c *retryingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	read := isReadRequest(req)
+	for attempt := 0; ; attempt++ {
+		var sent atomic.Bool
+		trace := &httptrace.ClientTrace{
+			WroteRequest: func(httptrace.WroteRequestInfo) { sent.Store(true) },
+		}
+		resp, err := c.client.Do(req.WithContext(httptrace.WithClientTrace(req.Context(), trace)))
+
+		wait, retry := c.retryWait(resp, err, attempt, read || (err != nil && !sent.Load()))
+		if !retry || (req.Body != nil && req.GetBody == nil) {
+			return resp, err
+		}
+
+		fields := map[string]any{"url": req.URL.String(), "attempt": attempt + 1, "wait": wait.String()}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			resp.Body.Close()
+		}
+		tflog.Debug(c.logCtx, "Retrying Slack API request", fields)
+
+		if err := c.clock.Sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// retryWait decides whether a request should be retried after the given
+// outcome, and how long to wait first. Network failures and server errors
+// are only retried when the request is replayable.
+func (c *retryingHTTPClient) retryWait(resp *http.Response, err error, attempt int, replayable bool) (time.Duration, bool) {
+	if attempt >= c.maxRetries {
+		return 0, false
+	}
+
+	switch {
+	case err != nil:
+		return c.backoff(attempt), replayable
+	case resp.StatusCode == http.StatusTooManyRequests:
+		seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+		if err != nil {
+			return c.backoff(attempt), true
+		}
+		// Waiting less than Slack asks for would only be rejected again,
+		// so give up and surface the rate limit instead.
+		wait := time.Duration(seconds) * time.Second
+		return wait, wait <= c.maxWait
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return c.backoff(attempt), replayable
+	default:
+		return 0, false
+	}
+}
+
+// readMethodVerbs are the last part of the names of the Slack API methods
+// that only read, and may be called again without side effects.
+var readMethodVerbs = map[string]bool{
+	"get":           true,
+	"history":       true,
+	"info":          true,
+	"list":          true,
+	"lookupByEmail": true,
+	"members":       true,
+	"test":          true,
+}
+
+// isReadRequest reports whether the request only reads. Slack API methods
+// are posted whatever they do, so they are told apart by name.
+func isReadRequest(req *http.Request) bool {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return true
+	}
+	method := path.Base(req.URL.Path)
+	return readMethodVerbs[method[strings.LastIndex(method, ".")+1:]]
+}
+
+// backoff returns a random wait of between half and all of the exponential
+// backoff for the given attempt, capped at the maximum wait.
+func (c *retryingHTTPClient) backoff(attempt int) time.Duration {
+	wait := c.maxWait
+	if attempt < 32 && retryBaseWait<<attempt < wait {
+		wait = retryBaseWait << attempt
+	}
+	if wait <= 0 {
+		return 0
+	}
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
@@ -0,0 +1,190 @@
+package slack
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testRetryingHTTPClient returns a retrying client using a fake clock, so
+// that its waits are recorded instead of slept.
+func testRetryingHTTPClient(maxRetries int, maxWait time.Duration) (*retryingHTTPClient, *fakeClock) {
+	clock := newFakeClock()
+	return newRetryingHTTPClient(context.Background(), &http.Client{}, clock, maxRetries, maxWait), clock
+}
+
+// testStatusServer starts a server answering with the given statuses in
+// turn, then with 200 OK, and records the request bodies it receives.
+func testStatusServer(t *testing.T, retryAfter string, statuses ...int) (*httptest.Server, *[]string) {
+	t.Helper()
+	var bodies []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		status := http.StatusOK
+		if len(bodies) <= len(statuses) {
+			status = statuses[len(bodies)-1]
+		}
+		if status == http.StatusTooManyRequests && retryAfter != "" {
+			w.Header().Set("Retry-After", retryAfter)
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &bodies
+}
+
+func TestRetryingHTTPClientRetryAfter(t *testing.T) {
+	server, bodies := testStatusServer(t, "2", http.StatusTooManyRequests)
+	c, clock := testRetryingHTTPClient(3, time.Minute)
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("channel=C123"))
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	waits := clock.Sleeps()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+	if len(waits) != 1 || waits[0] != 2*time.Second {
+		t.Errorf("expected a single 2s wait, got %v", waits)
+	}
+	for _, body := range *bodies {
+		if body != "channel=C123" {
+			t.Errorf("expected the request body to be resent, got %q", body)
+		}
+	}
+}
+
+func TestRetryingHTTPClientRetryAfterTooLong(t *testing.T) {
+	server, bodies := testStatusServer(t, "120", http.StatusTooManyRequests)
+	c, clock := testRetryingHTTPClient(3, time.Minute)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	waits := clock.Sleeps()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected the rate limit to be surfaced, got status %d", resp.StatusCode)
+	}
+	if len(waits) != 0 || len(*bodies) != 1 {
+		t.Errorf("expected no retries, got waits %v after %d requests", waits, len(*bodies))
+	}
+}
+
+func TestRetryingHTTPClientServerErrors(t *testing.T) {
+	server, bodies := testStatusServer(t, "", http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusInternalServerError)
+	c, clock := testRetryingHTTPClient(2, time.Minute)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	waits := clock.Sleeps()
+
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected the last server error once retries ran out, got status %d", resp.StatusCode)
+	}
+	if len(*bodies) != 3 {
+		t.Errorf("expected 3 requests, got %d", len(*bodies))
+	}
+	for i, wait := range waits {
+		limit := retryBaseWait << i
+		if wait < limit/2 || wait > limit {
+			t.Errorf("expected wait %d to be between %v and %v, got %v", i, limit/2, limit, wait)
+		}
+	}
+}
+
+func TestRetryingHTTPClientNoRetries(t *testing.T) {
+	server, bodies := testStatusServer(t, "1", http.StatusTooManyRequests)
+	c, clock := testRetryingHTTPClient(0, time.Minute)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	waits := clock.Sleeps()
+
+	if resp.StatusCode != http.StatusTooManyRequests || len(*bodies) != 1 || len(waits) != 0 {
+		t.Errorf("expected a single rate limited request, got status %d after %d requests and waits %v", resp.StatusCode, len(*bodies), waits)
+	}
+}
+
+func TestRetryingHTTPClientBackoffCap(t *testing.T) {
+	c, _ := testRetryingHTTPClient(10, 5*time.Second)
+
+	for attempt := 0; attempt < 40; attempt++ {
+		if wait := c.backoff(attempt); wait > 5*time.Second {
+			t.Errorf("expected attempt %d to wait at most 5s, got %v", attempt, wait)
+		}
+	}
+}
+
+func TestRetryingHTTPClientWriteServerError(t *testing.T) {
+	server, bodies := testStatusServer(t, "", http.StatusBadGateway)
+	c, clock := testRetryingHTTPClient(3, time.Minute)
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/api/chat.postMessage", strings.NewReader("channel=C123"))
+	req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(strings.NewReader("channel=C123")), nil }
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway || len(*bodies) != 1 || len(clock.Sleeps()) != 0 {
+		t.Errorf("expected the write not to be replayed, got status %d after %d requests", resp.StatusCode, len(*bodies))
+	}
+}
+
+func TestRetryingHTTPClientReadServerError(t *testing.T) {
+	server, bodies := testStatusServer(t, "", http.StatusBadGateway)
+	c, _ := testRetryingHTTPClient(3, time.Minute)
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/api/conversations.list", strings.NewReader("limit=100"))
+	req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(strings.NewReader("limit=100")), nil }
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || len(*bodies) != 2 {
+		t.Errorf("expected the read to be retried, got status %d after %d requests", resp.StatusCode, len(*bodies))
+	}
+}
+
+func TestRetryingHTTPClientWriteNotSent(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	c, clock := testRetryingHTTPClient(2, time.Minute)
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/api/chat.postMessage", strings.NewReader("channel=C123"))
+	req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(strings.NewReader("channel=C123")), nil }
+	if _, err := c.Do(req); err == nil {
+		t.Fatal("expected a connection error")
+	}
+
+	if waits := clock.Sleeps(); len(waits) != 2 {
+		t.Errorf("expected a write that was never sent to be retried, got waits %v", waits)
+	}
+}
@@ -0,0 +1,129 @@
+package slack
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// scopeRecorder remembers the OAuth scopes Slack reports granted to a
+// token in the X-OAuth-Scopes header of its responses.
+type scopeRecorder struct {
+	client httpDoer
+
+	mu     sync.Mutex
+	scopes map[string]bool
+}
+
+// Do sends the request and records the scopes reported in the response.
+func (r *scopeRecorder) Do(req *http.Request) (*http.Response, error) {
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if header := resp.Header.Values("X-OAuth-Scopes"); len(header) > 0 {
+		scopes := parseScopes(strings.Join(header, ","))
+		r.mu.Lock()
+		r.scopes = scopes
+		r.mu.Unlock()
+	}
+
+	return resp, nil
+}
+
+// granted returns the scopes recorded from the last response reporting
+// them, or nil if none has or r is nil. The returned map is replaced rather
+// than changed by later responses.
+func (r *scopeRecorder) granted() map[string]bool {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.scopes
+}
+
+// parseScopes parses a comma separated scope list.
+func parseScopes(header string) map[string]bool {
+	scopes := map[string]bool{}
+	for _, scope := range strings.Split(header, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes[scope] = true
+		}
+	}
+	return scopes
+}
+
+// scopeRequirement is an OAuth scope a data source or resource needs
+// because of how it is configured.
+type scopeRequirement struct {
+	// attribute is the attribute whose configuration needs the scope, or
+	// empty when the data source or resource always needs it.
+	attribute path.Path
+
+	// anyOf lists interchangeable scopes, any one of which satisfies the
+	// requirement, such as the bot and user token variants of a scope.
+	anyOf []string
+}
+
+// requireScope returns a requirement for any one of the given scopes.
+func requireScope(attribute path.Path, anyOf ...string) scopeRequirement {
+	return scopeRequirement{attribute: attribute, anyOf: anyOf}
+}
+
+// checkScopes returns an error for every requirement the token's granted
+// scopes, as last reported by Slack, do not satisfy. Nothing is checked when
+// Slack did not report the granted scopes.
+func (c *slackClient) checkScopes(requirements ...scopeRequirement) diag.Diagnostics {
+	var diags diag.Diagnostics
+	granted := c.scopes.granted()
+	if granted == nil {
+		return diags
+	}
+
+	for _, requirement := range requirements {
+		satisfied := false
+		for _, scope := range requirement.anyOf {
+			satisfied = satisfied || granted[scope]
+		}
+		if satisfied {
+			continue
+		}
+
+		missing := requirement.anyOf[0]
+		if len(requirement.anyOf) > 1 {
+			missing = "one of " + strings.Join(requirement.anyOf, ", ")
+		}
+		detail := "The Slack token is missing the " + missing + " scope"
+		if !requirement.attribute.Equal(path.Empty()) {
+			detail += ", needed because of the configured " + requirement.attribute.String()
+		}
+		detail += ". Add the scope to the Slack app and reinstall it, then update the token. " +
+			"Granted scopes: " + strings.Join(c.grantedScopes(), ", ") + "."
+
+		if requirement.attribute.Equal(path.Empty()) {
+			diags.AddError("Missing Slack API Scope", detail)
+		} else {
+			diags.AddAttributeError(requirement.attribute, "Missing Slack API Scope", detail)
+		}
+	}
+
+	return diags
+}
+
+// grantedScopes returns the token's granted scopes in sorted order.
+func (c *slackClient) grantedScopes() []string {
+	granted := c.scopes.granted()
+	scopes := make([]string, 0, len(granted))
+	for scope := range granted {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+	return scopes
+}
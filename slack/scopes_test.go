@@ -0,0 +1,81 @@
+package slack
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestParseScopes(t *testing.T) {
+	scopes := parseScopes("channels:read, users:read,,users:read.email ")
+
+	if len(scopes) != 3 || !scopes["channels:read"] || !scopes["users:read"] || !scopes["users:read.email"] {
+		t.Errorf("unexpected scopes: %v", scopes)
+	}
+}
+
+func TestCheckScopes(t *testing.T) {
+	client := &slackClient{scopes: &scopeRecorder{scopes: parseScopes("channels:read,users:read")}}
+
+	diags := client.checkScopes(
+		requireScope(path.Root("id"), "users:read"),
+		requireScope(path.Root("id"), "channels:read", "groups:read"),
+	)
+	if diags.HasError() {
+		t.Fatalf("expected granted scopes to satisfy the requirements, got %v", diags)
+	}
+
+	diags = client.checkScopes(
+		requireScope(path.Root("email"), "users:read.email"),
+		requireScope(path.Empty(), "usergroups:read"),
+	)
+	if diags.ErrorsCount() != 2 {
+		t.Fatalf("expected an error per missing scope, got %v", diags)
+	}
+
+	detail := diags[0].Detail()
+	if !strings.Contains(detail, "users:read.email") || !strings.Contains(detail, "email") {
+		t.Errorf("expected the error to name the scope and attribute, got %q", detail)
+	}
+	if !strings.Contains(detail, "channels:read, users:read") {
+		t.Errorf("expected the error to list the granted scopes, got %q", detail)
+	}
+}
+
+func TestCheckScopesUnknown(t *testing.T) {
+	client := &slackClient{}
+
+	if diags := client.checkScopes(requireScope(path.Empty(), "usergroups:read")); diags.HasError() {
+		t.Errorf("expected no check when the granted scopes are unknown, got %v", diags)
+	}
+}
+
+func TestCheckScopesLatest(t *testing.T) {
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"auth.test": func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("X-OAuth-Scopes", "channels:read")
+			fmt.Fprint(w, testIdentityResponse)
+		},
+		"users.info": func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("X-OAuth-Scopes", "channels:read,users:read")
+			fmt.Fprint(w, `{"ok":true,"user":{"id":"U1"}}`)
+		},
+	})
+	client, err := newSlackClient("xoxb-test", &http.Client{}, server.apiURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := client.checkScopes(requireScope(path.Empty(), "users:read")); !diags.HasError() {
+		t.Fatal("expected the scope not to be granted yet")
+	}
+	if _, err := client.GetUserInfo("U1"); err != nil {
+		t.Fatal(err)
+	}
+	if diags := client.checkScopes(requireScope(path.Empty(), "users:read")); diags.HasError() {
+		t.Errorf("expected the scope reported by the latest response to be seen, got %v", diags)
+	}
+}
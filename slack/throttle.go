@@ -0,0 +1,187 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiTier describes one of Slack's published rate limit tiers.
+type apiTier struct {
+	// perMinute is the sustained number of calls allowed per minute.
+	perMinute int
+	// burst is the number of calls that may be made back to back before
+	// the sustained rate applies.
+	burst int
+}
+
+// Slack's rate limit tiers, see https://api.slack.com/docs/rate-limits.
+var (
+	tier1 = apiTier{perMinute: 1, burst: 1}
+	tier2 = apiTier{perMinute: 20, burst: 3}
+	tier3 = apiTier{perMinute: 50, burst: 5}
+	tier4 = apiTier{perMinute: 100, burst: 10}
+)
+
+// methodTiers maps the Slack API methods the provider calls to their
+// published tier. Methods not listed are throttled as defaultTier.
+// chat.postMessage has its own limit of about one message per second per
+// channel, which tier3 stays within.
+var methodTiers = map[string]apiTier{
+	"admin.emoji.add":          tier2,
+	"admin.emoji.addAlias":     tier2,
+	"admin.emoji.remove":       tier2,
+	"admin.emoji.rename":       tier2,
+	"auth.test":                tier4,
+	"bookmarks.add":            tier2,
+	"bookmarks.edit":           tier2,
+	"bookmarks.list":           tier3,
+	"bookmarks.remove":         tier2,
+	"chat.delete":              tier3,
+	"chat.postMessage":         tier3,
+	"chat.update":              tier3,
+	"conversations.archive":    tier2,
+	"conversations.create":     tier2,
+	"conversations.history":    tier3,
+	"conversations.info":       tier3,
+	"conversations.invite":     tier3,
+	"conversations.kick":       tier3,
+	"conversations.list":       tier2,
+	"conversations.members":    tier4,
+	"conversations.rename":     tier2,
+	"conversations.setPurpose": tier2,
+	"conversations.setTopic":   tier2,
+	"conversations.unarchive":  tier2,
+	"emoji.add":                tier2,
+	"emoji.list":               tier2,
+	"pins.add":                 tier2,
+	"pins.list":                tier2,
+	"pins.remove":              tier2,
+	"team.profile.get":         tier3,
+	"usergroups.create":        tier2,
+	"usergroups.disable":       tier2,
+	"usergroups.enable":        tier2,
+	"usergroups.list":          tier2,
+	"usergroups.update":        tier2,
+	"usergroups.users.list":    tier2,
+	"usergroups.users.update":  tier2,
+	"users.info":               tier4,
+	"users.list":               tier2,
+	"users.lookupByEmail":      tier3,
+	"users.profile.get":        tier4,
+	"users.profile.set":        tier3,
+}
+
+// defaultTier applies to methods missing from methodTiers.
+var defaultTier = tier3
+
+// throttler spaces out calls to each Slack API method so that they stay
+// within the method's tier. A single throttler is shared by every data
+// source and resource of a configured provider.
+type throttler struct {
+	clock clock
+
+	mu       sync.Mutex
+	limiters map[string]*methodLimiter
+}
+
+// newThrottler returns a throttler measuring time with clock.
+func newThrottler(clock clock) *throttler {
+	return &throttler{
+		clock:    clock,
+		limiters: map[string]*methodLimiter{},
+	}
+}
+
+// reserve claims the next slot for a call to method and returns how long
+// the caller must wait before making it.
+func (t *throttler) reserve(method string) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	limiter, ok := t.limiters[method]
+	if !ok {
+		tier, ok := methodTiers[method]
+		if !ok {
+			tier = defaultTier
+		}
+		limiter = newMethodLimiter(tier)
+		t.limiters[method] = limiter
+	}
+
+	return limiter.reserve(t.clock.Now())
+}
+
+// methodLimiter is a generic cell rate limiter for a single method. It
+// tracks the theoretical arrival time of the next call: calls may run
+// ahead of it by up to the burst tolerance, and each call pushes it back
+// by one interval.
+type methodLimiter struct {
+	interval  time.Duration
+	tolerance time.Duration
+	arrival   time.Time
+}
+
+// newMethodLimiter returns a limiter enforcing tier.
+func newMethodLimiter(tier apiTier) *methodLimiter {
+	interval := time.Minute / time.Duration(tier.perMinute)
+	return &methodLimiter{
+		interval:  interval,
+		tolerance: time.Duration(tier.burst-1) * interval,
+	}
+}
+
+// reserve claims the next slot at or after now and returns the wait until
+// that slot.
+func (l *methodLimiter) reserve(now time.Time) time.Duration {
+	if l.arrival.Before(now) {
+		l.arrival = now
+	}
+
+	wait := l.arrival.Add(-l.tolerance).Sub(now)
+	if wait < 0 {
+		wait = 0
+	}
+
+	l.arrival = l.arrival.Add(l.interval)
+	return wait
+}
+
+// throttledHTTPClient delays each request until its Slack API method is
+// within its tier.
+type throttledHTTPClient struct {
+	client    httpDoer
+	throttler *throttler
+
+	// logCtx carries the provider logger, since the Slack client issues
+	// requests without a Terraform context.
+	logCtx context.Context
+}
+
+// newThrottledHTTPClient wraps client with throttler.
+func newThrottledHTTPClient(ctx context.Context, client httpDoer, throttler *throttler) *throttledHTTPClient {
+	return &throttledHTTPClient{
+		client:    client,
+		throttler: throttler,
+		logCtx:    ctx,
+	}
+}
+
+// Do waits for the request's method to be within its tier, then sends it.
+func (c *throttledHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	// The Slack client appends the method name to the base API URL.
+	method := path.Base(req.URL.Path)
+
+	if wait := c.throttler.reserve(method); wait > 0 {
+		tflog.Debug(c.logCtx, "Throttling Slack API request", map[string]any{"method": method, "wait": wait.String()})
+		if err := c.throttler.clock.Sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.client.Do(req)
+}
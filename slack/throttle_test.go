@@ -0,0 +1,134 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestThrottlerBurstThenSustainedRate(t *testing.T) {
+	clock := newFakeClock()
+	throttler := newThrottler(clock)
+
+	// users.list is Tier 2: a burst of 3, then one call every 3 seconds.
+	var waits []time.Duration
+	for i := 0; i < 5; i++ {
+		waits = append(waits, throttler.reserve("users.list"))
+	}
+
+	expected := []time.Duration{0, 0, 0, 3 * time.Second, 6 * time.Second}
+	for i := range expected {
+		if waits[i] != expected[i] {
+			t.Errorf("expected call %d to wait %v, got %v", i, expected[i], waits[i])
+		}
+	}
+}
+
+func TestThrottlerRecovers(t *testing.T) {
+	clock := newFakeClock()
+	throttler := newThrottler(clock)
+
+	for i := 0; i < 3; i++ {
+		throttler.reserve("users.list")
+	}
+	if wait := throttler.reserve("users.list"); wait == 0 {
+		t.Fatal("expected the fourth call in a burst to wait")
+	}
+
+	clock.Advance(time.Minute)
+	if wait := throttler.reserve("users.list"); wait != 0 {
+		t.Errorf("expected no wait once the burst has recovered, got %v", wait)
+	}
+}
+
+func TestThrottlerMethodsAreIndependent(t *testing.T) {
+	clock := newFakeClock()
+	throttler := newThrottler(clock)
+
+	// conversations.list is Tier 2, conversations.info is Tier 3.
+	for i := 0; i < 3; i++ {
+		throttler.reserve("conversations.list")
+	}
+	if wait := throttler.reserve("conversations.info"); wait != 0 {
+		t.Errorf("expected conversations.info not to wait on conversations.list, got %v", wait)
+	}
+}
+
+func TestThrottlerDefaultTier(t *testing.T) {
+	clock := newFakeClock()
+	throttler := newThrottler(clock)
+
+	var wait time.Duration
+	for i := 0; i < defaultTier.burst+1; i++ {
+		wait = throttler.reserve("unknown.method")
+	}
+	if wait != time.Minute/time.Duration(defaultTier.perMinute) {
+		t.Errorf("expected an unknown method to be throttled as the default tier, got %v", wait)
+	}
+}
+
+func TestThrottlerConcurrent(t *testing.T) {
+	clock := newFakeClock()
+	throttler := newThrottler(clock)
+
+	// conversations.info is Tier 3: a burst of 5, then one call every 1.2 seconds.
+	var (
+		mu    sync.Mutex
+		waits []time.Duration
+		wg    sync.WaitGroup
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait := throttler.reserve("conversations.info")
+			mu.Lock()
+			waits = append(waits, wait)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	sort.Slice(waits, func(i, j int) bool { return waits[i] < waits[j] })
+	interval := 1200 * time.Millisecond
+	expected := []time.Duration{0, 0, 0, 0, 0, interval, 2 * interval, 3 * interval}
+	for i := range expected {
+		if waits[i] != expected[i] {
+			t.Errorf("expected the concurrent calls to wait %v, got %v", expected, waits)
+			break
+		}
+	}
+}
+
+func TestThrottledHTTPClient(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+	}))
+	t.Cleanup(server.Close)
+
+	clock := newFakeClock()
+	c := newThrottledHTTPClient(context.Background(), &http.Client{}, newThrottler(clock))
+
+	// users.info is Tier 4: a burst of 10, then one call every 600 milliseconds.
+	for i := 0; i < 11; i++ {
+		req, _ := http.NewRequest(http.MethodPost, server.URL+"/api/users.info", nil)
+		resp, err := c.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	if len(paths) != 11 {
+		t.Errorf("expected 11 requests, got %d", len(paths))
+	}
+	sleeps := clock.Sleeps()
+	if len(sleeps) != 1 || sleeps[0] != 600*time.Millisecond {
+		t.Errorf("expected a single 600ms wait, got %v", sleeps)
+	}
+}
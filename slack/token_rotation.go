@@ -0,0 +1,198 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// rotatingTokenHTTPClient sends requests with an access token obtained from
+// a refresh token through oauth.v2.access, for Slack apps with token
+// rotation enabled. When Slack reports the access token expired, it is
+// refreshed and the request resent.
+type rotatingTokenHTTPClient struct {
+	client       httpDoer
+	apiURL       string
+	clientID     string
+	clientSecret string
+
+	mu           sync.Mutex
+	accessToken  string
+	refreshToken string
+
+	// logCtx carries the provider logger, since the Slack client issues
+	// requests without a Terraform context.
+	logCtx context.Context
+}
+
+// newRotatingTokenHTTPClient exchanges the refresh token for a first access
+// token, returning a client that keeps it fresh.
+func newRotatingTokenHTTPClient(ctx context.Context, client httpDoer, apiURL, clientID, clientSecret, refreshToken string) (*rotatingTokenHTTPClient, error) {
+	c := &rotatingTokenHTTPClient{
+		client:       client,
+		apiURL:       apiURL,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		refreshToken: refreshToken,
+		logCtx:       ctx,
+	}
+
+	if _, err := c.refresh(""); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// token returns the current access token.
+func (c *rotatingTokenHTTPClient) token() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.accessToken
+}
+
+// refresh exchanges the refresh token for a new access token, unless the
+// expired token has already been replaced by a concurrent refresh.
+func (c *rotatingTokenHTTPClient) refresh(expired string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.accessToken != expired {
+		return c.accessToken, nil
+	}
+
+	response, err := oauthV2Access(c.client, c.apiURL, url.Values{
+		"client_id":     {c.clientID},
+		"client_secret": {c.clientSecret},
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {c.refreshToken},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	c.accessToken = response.AccessToken
+	// Slack may rotate the refresh token too.
+	if response.RefreshToken != "" {
+		c.refreshToken = response.RefreshToken
+	}
+
+	tflog.Debug(c.logCtx, "Refreshed Slack access token", map[string]any{"token_type": response.TokenType, "expires_in": response.ExpiresIn})
+	return c.accessToken, nil
+}
+
+// oauthV2Access calls oauth.v2.access with the given parameters to obtain an
+// access token.
+func oauthV2Access(client httpDoer, apiURL string, values url.Values) (*slack.OAuthV2Response, error) {
+	var response slack.OAuthV2Response
+	if err := postForm(client, apiURL, "oauth.v2.access", values, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// Do sends the request with the current access token, refreshing the token
+// and resending the request once if Slack reports it expired.
+func (c *rotatingTokenHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	token := c.token()
+	if err := setRequestToken(req, token); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return resp, err
+	}
+
+	expired, err := isTokenExpired(resp)
+	if err != nil || !expired {
+		return resp, err
+	}
+	resp.Body.Close()
+
+	tflog.Debug(c.logCtx, "Slack access token expired, refreshing", map[string]any{"url": req.URL.String()})
+	token, err = c.refresh(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = body
+	}
+	if err := setRequestToken(req, token); err != nil {
+		return nil, err
+	}
+	return c.client.Do(req)
+}
+
+// setRequestToken replaces the token the Slack client put in the request's
+// Authorization header or form body.
+func setRequestToken(req *http.Request, token string) error {
+	if strings.HasPrefix(req.Header.Get("Authorization"), "Bearer ") {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	if req.Header.Get("Content-Type") != "application/x-www-form-urlencoded" || req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	raw, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	values, err := url.ParseQuery(string(raw))
+	if err != nil {
+		return err
+	}
+	if !values.Has("token") {
+		return nil
+	}
+	values.Set("token", token)
+
+	encoded := []byte(values.Encode())
+	req.Body = io.NopCloser(bytes.NewReader(encoded))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(encoded)), nil
+	}
+	req.ContentLength = int64(len(encoded))
+	return nil
+}
+
+// isTokenExpired reports whether the response is Slack's token_expired
+// error, leaving the response body readable.
+func isTokenExpired(resp *http.Response) (bool, error) {
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		return false, nil
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return false, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(raw))
+
+	var response slack.SlackResponse
+	if err := json.Unmarshal(raw, &response); err != nil {
+		return false, nil
+	}
+	return !response.Ok && response.Error == "token_expired", nil
+}
@@ -0,0 +1,177 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testRotationServer starts a testServer issuing numbered access and
+// refresh tokens from oauth.v2.access. Once expire is called, users.info
+// reports every access token issued so far as expired.
+func testRotationServer(t *testing.T) (server *testServer, expire func()) {
+	t.Helper()
+	var (
+		mu        sync.Mutex
+		issued    int
+		expiredAt int
+	)
+
+	server = newTestServer(t, map[string]http.HandlerFunc{
+		"oauth.v2.access": func(w http.ResponseWriter, _ *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			issued++
+			fmt.Fprintf(w, `{"ok":true,"access_token":"xoxe.xoxb-%[1]d","refresh_token":"xoxe-%[1]d","expires_in":43200}`, issued)
+		},
+		"users.info": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			var n int
+			fmt.Sscanf(r.FormValue("token"), "xoxe.xoxb-%d", &n)
+			if n <= expiredAt {
+				fmt.Fprint(w, `{"ok":false,"error":"token_expired"}`)
+				return
+			}
+			fmt.Fprintf(w, `{"ok":true,"user":{"id":%q}}`, r.FormValue("user"))
+		},
+	})
+
+	expire = func() {
+		mu.Lock()
+		defer mu.Unlock()
+		expiredAt = issued
+	}
+	return server, expire
+}
+
+func TestRotatingTokenHTTPClient(t *testing.T) {
+	server, expire := testRotationServer(t)
+
+	c, err := newRotatingTokenHTTPClient(context.Background(), &http.Client{}, server.apiURL(), "client", "secret", "xoxe-0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token := c.token(); token != "xoxe.xoxb-1" {
+		t.Fatalf("expected the first access token, got %q", token)
+	}
+
+	api := slack.New(c.token(), slack.OptionHTTPClient(c), slack.OptionAPIURL(server.apiURL()))
+	if _, err := api.GetUserInfo("U1"); err != nil {
+		t.Fatal(err)
+	}
+
+	expire()
+	user, err := api.GetUserInfo("U2")
+	if err != nil {
+		t.Fatalf("expected the expired token to be refreshed transparently, got %v", err)
+	}
+	if user.ID != "U2" {
+		t.Errorf("expected user U2, got %q", user.ID)
+	}
+
+	expected := []string{"xoxe.xoxb-1", "xoxe.xoxb-1", "xoxe.xoxb-2"}
+	if userTokens := server.formValues("users.info", "token"); fmt.Sprint(userTokens) != fmt.Sprint(expected) {
+		t.Errorf("expected users.info to see tokens %v, got %v", expected, userTokens)
+	}
+	if refreshTokens := server.formValues("oauth.v2.access", "refresh_token"); fmt.Sprint(refreshTokens) != fmt.Sprint([]string{"xoxe-0", "xoxe-1"}) {
+		t.Errorf("expected the rotated refresh token to be used, got %v", refreshTokens)
+	}
+}
+
+func TestRotatingTokenHTTPClientStaleRefresh(t *testing.T) {
+	server, _ := testRotationServer(t)
+
+	c, err := newRotatingTokenHTTPClient(context.Background(), &http.Client{}, server.apiURL(), "client", "secret", "xoxe-0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A request that saw an older token expire should pick up the token a
+	// concurrent refresh already obtained.
+	token, err := c.refresh("xoxe.xoxb-0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refreshTokens := server.formValues("oauth.v2.access", "refresh_token"); token != "xoxe.xoxb-1" || len(refreshTokens) != 1 {
+		t.Errorf("expected no further refresh, got token %q after refreshes %v", token, refreshTokens)
+	}
+}
+
+func TestProviderConfigureRefreshToken(t *testing.T) {
+	testClearTokenEnv(t)
+	server, _ := testRotationServer(t)
+	t.Setenv("SLACK_API_URL", server.apiURL())
+	t.Setenv("SLACK_CLIENT_ID", "client")
+	t.Setenv("SLACK_CLIENT_SECRET", "secret")
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"refresh_token": tftypes.NewValue(tftypes.String, "xoxe-0"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	clients := resp.ResourceData.(*slackClients)
+	if clients.bot == nil || clients.bot != clients.user || clients.bot != clients.admin {
+		t.Errorf("expected the refreshed token to stand in for every token type, got %+v", clients)
+	}
+	if refreshTokens := server.formValues("oauth.v2.access", "refresh_token"); len(refreshTokens) != 1 {
+		t.Errorf("expected a single refresh at configure time, got %v", refreshTokens)
+	}
+}
+
+func TestProviderConfigureIncompleteRefreshToken(t *testing.T) {
+	testClearTokenEnv(t)
+	t.Setenv("SLACK_CLIENT_ID", "")
+	t.Setenv("SLACK_CLIENT_SECRET", "")
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"refresh_token": tftypes.NewValue(tftypes.String, "xoxe-0"),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a refresh token without client credentials")
+	}
+}
+
+func TestProviderConfigureTokenIgnoresRefreshTokenEnv(t *testing.T) {
+	testClearTokenEnv(t)
+	server, _ := testRotationServer(t)
+	t.Setenv("SLACK_API_URL", server.apiURL())
+	t.Setenv("SLACK_CLIENT_ID", "client")
+	t.Setenv("SLACK_CLIENT_SECRET", "secret")
+	t.Setenv("SLACK_REFRESH_TOKEN", "xoxe-0")
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"token": tftypes.NewValue(tftypes.String, "xoxb-config"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if requests := server.requestsTo("oauth.v2.access"); len(requests) != 0 {
+		t.Errorf("expected the configured token to disable token rotation, got %d refreshes", len(requests))
+	}
+	if tokens := server.formValues("auth.test", "token"); len(tokens) == 0 || tokens[0] != "xoxb-config" {
+		t.Errorf("expected auth.test to be called with the configured token, got %v", tokens)
+	}
+}
+
+func TestProviderConfigureConflictingTokenEnv(t *testing.T) {
+	testClearTokenEnv(t)
+	t.Setenv("SLACK_TOKEN", "xoxb-env")
+	t.Setenv("SLACK_CLIENT_ID", "client")
+	t.Setenv("SLACK_CLIENT_SECRET", "secret")
+	t.Setenv("SLACK_REFRESH_TOKEN", "xoxe-0")
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for SLACK_TOKEN and SLACK_REFRESH_TOKEN set together")
+	}
+}
@@ -0,0 +1,118 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestReadTokenFile(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "token")
+	if err := os.WriteFile(path, []byte("  xoxb-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	token, err := readTokenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "xoxb-file" {
+		t.Errorf("expected the trimmed token, got %q", token)
+	}
+
+	empty := filepath.Join(dir, "empty")
+	if err := os.WriteFile(empty, []byte("\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readTokenFile(empty); err == nil {
+		t.Error("expected an error for an empty token file")
+	}
+
+	if _, err := readTokenFile(filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected an error for a missing token file")
+	}
+}
+
+func TestRunTokenCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test commands assume a POSIX shell")
+	}
+	ctx := context.Background()
+
+	token, err := runTokenCommand(ctx, "echo '  xoxb-command  '")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "xoxb-command" {
+		t.Errorf("expected the trimmed token, got %q", token)
+	}
+
+	if _, err := runTokenCommand(ctx, "true"); err == nil {
+		t.Error("expected an error for a command printing nothing")
+	}
+
+	_, err = runTokenCommand(ctx, "echo 'vault is sealed' >&2; exit 2")
+	if err == nil || !strings.Contains(err.Error(), "vault is sealed") {
+		t.Errorf("expected the command's standard error in the error, got %v", err)
+	}
+}
+
+func TestProviderConfigureTokenFile(t *testing.T) {
+	testClearTokenEnv(t)
+	server := newTestServer(t, nil)
+	t.Setenv("SLACK_API_URL", server.apiURL())
+
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("xoxb-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"token_file": tftypes.NewValue(tftypes.String, path),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if tokens := server.formValues("", "token"); fmt.Sprint(tokens) != "[xoxb-file]" {
+		t.Errorf("expected auth.test to be called with the file's token, got %v", tokens)
+	}
+}
+
+func TestProviderConfigureTokenCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test commands assume a POSIX shell")
+	}
+	testClearTokenEnv(t)
+	server := newTestServer(t, nil)
+	t.Setenv("SLACK_API_URL", server.apiURL())
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"token_command": tftypes.NewValue(tftypes.String, "echo xoxb-command"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if tokens := server.formValues("", "token"); fmt.Sprint(tokens) != "[xoxb-command]" {
+		t.Errorf("expected auth.test to be called with the command's token, got %v", tokens)
+	}
+}
+
+func TestProviderConfigureTokenFileMissing(t *testing.T) {
+	testClearTokenEnv(t)
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"token_file": tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "missing")),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a missing token file")
+	}
+}
@@ -2,19 +2,25 @@ package slack
 
 import (
 	"context"
+	"strings"
 
 	"github.com/slack-go/slack"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &userDataSource{}
-	_ datasource.DataSourceWithConfigure = &userDataSource{}
+	_ datasource.DataSource                     = &userDataSource{}
+	_ datasource.DataSourceWithConfigure        = &userDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &userDataSource{}
 )
 
 // NewUserDataSource is a helper function to simplify the provider implementation.
@@ -24,18 +30,21 @@ func NewUserDataSource() datasource.DataSource {
 
 // userDataSource is the data source implementation.
 type userDataSource struct {
-	client *slack.Client
+	client *slackClient
 }
 
 // userDataSourceModel maps the data source schema data.
 type userDataSourceModel struct {
-	User userModel `tfsdk:"user"`
+	userModel
+	IncludeFields types.Bool `tfsdk:"include_fields"`
+	FieldLabels   types.Bool `tfsdk:"field_labels"`
 }
 
 type userModel struct {
 	ID                types.String         `tfsdk:"id"`
 	Color             types.String         `tfsdk:"color"`
 	Deleted           types.Bool           `tfsdk:"deleted"`
+	Email             types.String         `tfsdk:"email"`
 	EnterpriseUser    *enterpriseUserModel `tfsdk:"enterprise_user"`
 	IsAdmin           types.Bool           `tfsdk:"is_admin"`
 	IsAppUser         types.Bool           `tfsdk:"is_app_user"`
@@ -58,26 +67,38 @@ type userModel struct {
 type userProfileModel struct {
 	DisplayName           types.String `tfsdk:"display_name"`
 	DisplayNameNormalized types.String `tfsdk:"display_name_normalized"`
-	//Fields types.Map `tfsdk:"fields"`
-	FirstName          types.String `tfsdk:"first_name"`
-	Image192           types.String `tfsdk:"image_192"`
-	Image24            types.String `tfsdk:"image_24"`
-	Image32            types.String `tfsdk:"image_32"`
-	Image48            types.String `tfsdk:"image_48"`
-	Image512           types.String `tfsdk:"image_512"`
-	Image72            types.String `tfsdk:"image_72"`
-	ImageOriginal      types.String `tfsdk:"image_original"`
-	LastName           types.String `tfsdk:"last_name"`
-	Phone              types.String `tfsdk:"phone"`
-	RealName           types.String `tfsdk:"real_name"`
-	RealNameNormalized types.String `tfsdk:"real_name_normalized"`
-	StatusEmoji        types.String `tfsdk:"status_emoji"`
-	StatusExpiration   types.Int64  `tfsdk:"status_expiration"`
-	StatusText         types.String `tfsdk:"status_text"`
-	Team               types.String `tfsdk:"team"`
-	Title              types.String `tfsdk:"title"`
+	Fields                types.Map    `tfsdk:"fields"`
+	FirstName             types.String `tfsdk:"first_name"`
+	Image192              types.String `tfsdk:"image_192"`
+	Image24               types.String `tfsdk:"image_24"`
+	Image32               types.String `tfsdk:"image_32"`
+	Image48               types.String `tfsdk:"image_48"`
+	Image512              types.String `tfsdk:"image_512"`
+	Image72               types.String `tfsdk:"image_72"`
+	ImageOriginal         types.String `tfsdk:"image_original"`
+	LastName              types.String `tfsdk:"last_name"`
+	Phone                 types.String `tfsdk:"phone"`
+	RealName              types.String `tfsdk:"real_name"`
+	RealNameNormalized    types.String `tfsdk:"real_name_normalized"`
+	StatusEmoji           types.String `tfsdk:"status_emoji"`
+	StatusExpiration      types.Int64  `tfsdk:"status_expiration"`
+	StatusText            types.String `tfsdk:"status_text"`
+	Team                  types.String `tfsdk:"team"`
+	Title                 types.String `tfsdk:"title"`
 }
 
+// userProfileFieldModel maps a custom profile field.
+type userProfileFieldModel struct {
+	Value types.String `tfsdk:"value"`
+	Alt   types.String `tfsdk:"alt"`
+}
+
+// userProfileFieldType is the object type of userProfileFieldModel.
+var userProfileFieldType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"value": types.StringType,
+	"alt":   types.StringType,
+}}
+
 type enterpriseUserModel struct {
 	EnterpriseID   types.String `tfsdk:"enterprise_id"`
 	EnterpriseName types.String `tfsdk:"enterprise_name"`
@@ -88,12 +109,12 @@ type enterpriseUserModel struct {
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *userDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *userDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*slack.Client)
+	d.client = req.ProviderData.(*slackClients).client(tokenTypeBot, &resp.Diagnostics)
 
 }
 
@@ -102,194 +123,260 @@ func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_user"
 }
 
+// ConfigValidators ensures that exactly one lookup key is configured.
+func (d *userDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("email"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 // Schema defines the schema for the data source.
 func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := userAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "Identifier for this workspace user. Conflicts with email and name.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["email"] = schema.StringAttribute{
+		Description: "The user's email address. Conflicts with id and name.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "The user's username. Deprecated by Slack, but still usable as a lookup key. Conflicts with id and email.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["include_fields"] = schema.BoolAttribute{
+		Description: "Read the user's custom profile fields into profile.fields, which takes an extra call to users.profile.get. " +
+			"Requires the users.profile:read scope.",
+		Optional: true,
+	}
+	attributes["field_labels"] = schema.BoolAttribute{
+		Description: "Read the user's custom profile fields like include_fields, keyed by the labels the workspace gives them, such as Manager, " +
+			"instead of by field ID. Fields without a label, or sharing their label with another field, keep their ID. " +
+			"Requires the users.profile:read scope.",
+		Optional: true,
+	}
+
 	resp.Schema = schema.Schema{
-		Description: "Fetch a user.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Identifier for this workspace user.",
-				Required:    true,
-			},
-			"real_name": schema.StringAttribute{
-				Description: "The user's first and last name",
-				Computed:    true,
-			},
-			"color": schema.StringAttribute{
-				Description: "Used in some clients to display a special username color.",
-				Computed:    true,
-			},
-			"deleted": schema.BoolAttribute{
-				Description: "This user has been deactivated when the value of this field is true.",
-				Computed:    true,
-			},
-			"is_admin": schema.BoolAttribute{
-				Description: "Indicates whether the user is an Admin of the current workspace.",
-				Computed:    true,
-			},
-			"is_app_user": schema.BoolAttribute{
-				Description: "Indicates whether the user is an authorized user of the calling app.",
-				Computed:    true,
-			},
-			"is_bot": schema.BoolAttribute{
-				Description: "Indicates whether the user is a bot user.",
-				Computed:    true,
-			},
-			"is_stranger": schema.BoolAttribute{
-				Description: "If true, this user belongs to a different workspace than the one associated with your app's token, and isn't in any shared channels visible to your app.",
-				Computed:    true,
-			},
-			"is_owner": schema.BoolAttribute{
-				Description: "Indicates whether the user is an Owner of the current workspace.",
-				Computed:    true,
-			},
-			"is_primary_owner": schema.BoolAttribute{
-				Description: "Indicates whether the user is the Primary Owner of the current workspace.",
-				Computed:    true,
-			},
-			"is_restricted": schema.BoolAttribute{
-				Description: "Indicates whether or not the user is a guest user.",
-				Computed:    true,
-			},
-			"is_ultra_restricted": schema.BoolAttribute{
-				Description: "Indicates whether or not the user is a single-channel guest.",
-				Computed:    true,
-			},
-			"name": schema.StringAttribute{
-				Description: "Deprecated. It once indicated the preferred username for a user.",
-				Computed:    true,
-			},
-			"team_id": schema.StringAttribute{
-				Description: "Identifier for this workspace user's team.",
-				Computed:    true,
-			},
-			"tz": schema.StringAttribute{
-				Description: "A human-readable string for the geographic timezone-related region this user has specified in their account.",
-				Computed:    true,
-			},
-			"tz_label": schema.StringAttribute{
-				Description: "Describes the commonly used name of the timezone defined in tz.",
-				Computed:    true,
-			},
-			"tz_offset": schema.Int64Attribute{
-				Description: "Indicates the number of seconds to offset UTC by for this user's timezone.",
-				Computed:    true,
-			},
-			"updated": schema.StringAttribute{
-				Description: "A Unix timestamp indicating when the user object was last updated.",
-				Computed:    true,
-			},
-			"profile": schema.SingleNestedAttribute{
-				Description: "The profile object contains the default fields of a user's workspace profile.",
-				Computed:    true,
-				Attributes: map[string]schema.Attribute{
-					"title": schema.StringAttribute{
-						Description: "The user's title.",
-						Computed:    true,
-					},
-					"phone": schema.StringAttribute{
-						Description: "The user's phone number, in any format.",
-						Computed:    true,
-					},
-					"real_name": schema.StringAttribute{
-						Description: "The user's first and last name.",
-						Computed:    true,
-					},
-					"real_name_normalized": schema.StringAttribute{
-						Description: "The real_name field, but with any non-Latin characters filtered out.",
-						Computed:    true,
-					},
-					"display_name": schema.StringAttribute{
-						Description: "The display name the user has chosen to identify themselves by in their workspace profile.",
-						Computed:    true,
-					},
-					"display_name_normalized": schema.StringAttribute{
-						Description: "The display_name field, but with any non-Latin characters filtered out.",
-						Computed:    true,
-					},
-					"status_text": schema.StringAttribute{
-						Description: "The displayed text of up to 100 characters.",
-						Computed:    true,
-					},
-					"status_emoji": schema.StringAttribute{
-						Description: "The displayed emoji that is enabled for the Slack team, such as :train:.",
-						Computed:    true,
-					},
-					"status_expiration": schema.Int64Attribute{
-						Description: "The Unix Timestamp of when the status will expire.",
-						Computed:    true,
-					},
-					"image_original": schema.StringAttribute{
-						Description: "Contains the URL for the original square ratio, web-viewable images (GIFs, JPEGs, or PNGs) that represent a user's profile picture.",
-						Computed:    true,
-					},
-					"first_name": schema.StringAttribute{
-						Description: "The user's first name.",
-						Computed:    true,
-					},
-					"last_name": schema.StringAttribute{
-						Description: "The user's last name.",
-						Computed:    true,
-					},
-					"image_24": schema.StringAttribute{
-						Description: "Contains the URL for the 24-pixel square ratio, web-viewable images (GIFs, JPEGs, or PNGs) that represent a user's profile picture.",
-						Computed:    true,
-					},
-					"image_32": schema.StringAttribute{
-						Description: "Contains the URL for the 32-pixel square ratio, web-viewable images (GIFs, JPEGs, or PNGs) that represent a user's profile picture.",
-						Computed:    true,
-					},
-					"image_48": schema.StringAttribute{
-						Description: "Contains the URL for the 48-pixel square ratio, web-viewable images (GIFs, JPEGs, or PNGs) that represent a user's profile picture.",
-						Computed:    true,
-					},
-					"image_72": schema.StringAttribute{
-						Description: "Contains the URL for the 72-pixel square ratio, web-viewable images (GIFs, JPEGs, or PNGs) that represent a user's profile picture.",
-						Computed:    true,
-					},
-					"image_192": schema.StringAttribute{
-						Description: "Contains the URL for the 192-pixel square ratio, web-viewable images (GIFs, JPEGs, or PNGs) that represent a user's profile picture.",
-						Computed:    true,
-					},
-					"image_512": schema.StringAttribute{
-						Description: "Contains the URL for the 512-pixel square ratio, web-viewable images (GIFs, JPEGs, or PNGs) that represent a user's profile picture.",
-						Computed:    true,
-					},
-					"team": schema.StringAttribute{
-						Description: "The user's team ID.",
-						Computed:    true,
+		Description: "Fetch a user by ID, email address or username.",
+		Attributes:  attributes,
+	}
+}
+
+// userAttributes returns the computed attributes of a user, shared by the
+// slack_user and slack_users data sources.
+func userAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Identifier for this workspace user.",
+			Computed:    true,
+		},
+		"email": schema.StringAttribute{
+			Description: "The user's email address.",
+			Computed:    true,
+		},
+		"real_name": schema.StringAttribute{
+			Description: "The user's first and last name",
+			Computed:    true,
+		},
+		"color": schema.StringAttribute{
+			Description: "Used in some clients to display a special username color.",
+			Computed:    true,
+		},
+		"deleted": schema.BoolAttribute{
+			Description: "This user has been deactivated when the value of this field is true.",
+			Computed:    true,
+		},
+		"is_admin": schema.BoolAttribute{
+			Description: "Indicates whether the user is an Admin of the current workspace.",
+			Computed:    true,
+		},
+		"is_app_user": schema.BoolAttribute{
+			Description: "Indicates whether the user is an authorized user of the calling app.",
+			Computed:    true,
+		},
+		"is_bot": schema.BoolAttribute{
+			Description: "Indicates whether the user is a bot user.",
+			Computed:    true,
+		},
+		"is_stranger": schema.BoolAttribute{
+			Description: "If true, this user belongs to a different workspace than the one associated with your app's token, and isn't in any shared channels visible to your app.",
+			Computed:    true,
+		},
+		"is_owner": schema.BoolAttribute{
+			Description: "Indicates whether the user is an Owner of the current workspace.",
+			Computed:    true,
+		},
+		"is_primary_owner": schema.BoolAttribute{
+			Description: "Indicates whether the user is the Primary Owner of the current workspace.",
+			Computed:    true,
+		},
+		"is_restricted": schema.BoolAttribute{
+			Description: "Indicates whether or not the user is a guest user.",
+			Computed:    true,
+		},
+		"is_ultra_restricted": schema.BoolAttribute{
+			Description: "Indicates whether or not the user is a single-channel guest.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The user's username. Deprecated by Slack, but still usable as a lookup key.",
+			Computed:    true,
+		},
+		"team_id": schema.StringAttribute{
+			Description: "Identifier for this workspace user's team.",
+			Computed:    true,
+		},
+		"tz": schema.StringAttribute{
+			Description: "A human-readable string for the geographic timezone-related region this user has specified in their account.",
+			Computed:    true,
+		},
+		"tz_label": schema.StringAttribute{
+			Description: "Describes the commonly used name of the timezone defined in tz.",
+			Computed:    true,
+		},
+		"tz_offset": schema.Int64Attribute{
+			Description: "Indicates the number of seconds to offset UTC by for this user's timezone.",
+			Computed:    true,
+		},
+		"updated": schema.StringAttribute{
+			Description: "A Unix timestamp indicating when the user object was last updated.",
+			Computed:    true,
+		},
+		"profile": schema.SingleNestedAttribute{
+			Description: "The profile object contains the default fields of a user's workspace profile.",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"title": schema.StringAttribute{
+					Description: "The user's title.",
+					Computed:    true,
+				},
+				"phone": schema.StringAttribute{
+					Description: "The user's phone number, in any format.",
+					Computed:    true,
+				},
+				"real_name": schema.StringAttribute{
+					Description: "The user's first and last name.",
+					Computed:    true,
+				},
+				"real_name_normalized": schema.StringAttribute{
+					Description: "The real_name field, but with any non-Latin characters filtered out.",
+					Computed:    true,
+				},
+				"display_name": schema.StringAttribute{
+					Description: "The display name the user has chosen to identify themselves by in their workspace profile.",
+					Computed:    true,
+				},
+				"display_name_normalized": schema.StringAttribute{
+					Description: "The display_name field, but with any non-Latin characters filtered out.",
+					Computed:    true,
+				},
+				"status_text": schema.StringAttribute{
+					Description: "The displayed text of up to 100 characters.",
+					Computed:    true,
+				},
+				"status_emoji": schema.StringAttribute{
+					Description: "The displayed emoji that is enabled for the Slack team, such as :train:.",
+					Computed:    true,
+				},
+				"status_expiration": schema.Int64Attribute{
+					Description: "The Unix Timestamp of when the status will expire.",
+					Computed:    true,
+				},
+				"image_original": schema.StringAttribute{
+					Description: "Contains the URL for the original square ratio, web-viewable images (GIFs, JPEGs, or PNGs) that represent a user's profile picture.",
+					Computed:    true,
+				},
+				"first_name": schema.StringAttribute{
+					Description: "The user's first name.",
+					Computed:    true,
+				},
+				"last_name": schema.StringAttribute{
+					Description: "The user's last name.",
+					Computed:    true,
+				},
+				"image_24": schema.StringAttribute{
+					Description: "Contains the URL for the 24-pixel square ratio, web-viewable images (GIFs, JPEGs, or PNGs) that represent a user's profile picture.",
+					Computed:    true,
+				},
+				"image_32": schema.StringAttribute{
+					Description: "Contains the URL for the 32-pixel square ratio, web-viewable images (GIFs, JPEGs, or PNGs) that represent a user's profile picture.",
+					Computed:    true,
+				},
+				"image_48": schema.StringAttribute{
+					Description: "Contains the URL for the 48-pixel square ratio, web-viewable images (GIFs, JPEGs, or PNGs) that represent a user's profile picture.",
+					Computed:    true,
+				},
+				"image_72": schema.StringAttribute{
+					Description: "Contains the URL for the 72-pixel square ratio, web-viewable images (GIFs, JPEGs, or PNGs) that represent a user's profile picture.",
+					Computed:    true,
+				},
+				"image_192": schema.StringAttribute{
+					Description: "Contains the URL for the 192-pixel square ratio, web-viewable images (GIFs, JPEGs, or PNGs) that represent a user's profile picture.",
+					Computed:    true,
+				},
+				"image_512": schema.StringAttribute{
+					Description: "Contains the URL for the 512-pixel square ratio, web-viewable images (GIFs, JPEGs, or PNGs) that represent a user's profile picture.",
+					Computed:    true,
+				},
+				"team": schema.StringAttribute{
+					Description: "The user's team ID.",
+					Computed:    true,
+				},
+				"fields": schema.MapNestedAttribute{
+					Description: "The user's custom profile fields, keyed by field ID. Only read by slack_user with include_fields or field_labels set, " +
+						"as users.info and users.list do not return them.",
+					Computed: true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"value": schema.StringAttribute{
+								Description: "The value of the field. Fields of the user type hold a user ID.",
+								Computed:    true,
+							},
+							"alt": schema.StringAttribute{
+								Description: "The text shown in place of the value, such as a link's title.",
+								Computed:    true,
+							},
+						},
 					},
 				},
 			},
-			"enterprise_user": schema.SingleNestedAttribute{
-				Description: "An object containing info related to an Enterprise Grid user.",
-				Computed:    true,
-				Attributes: map[string]schema.Attribute{
-					"enterprise_id": schema.StringAttribute{
-						Description: "A unique ID for the Enterprise Grid organization this user belongs to.",
-						Computed:    true,
-					},
-					"enterprise_name": schema.StringAttribute{
-						Description: "A display name for the Enterprise Grid organization.",
-						Computed:    true,
-					},
-					"id": schema.StringAttribute{
-						Description: "This user's ID - some Grid users have a kind of dual identity — a local, workspace-centric user ID as well as a Grid-wise user ID, called the Enterprise user ID.",
-						Computed:    true,
-					},
-					"is_admin": schema.BoolAttribute{
-						Description: "Indicates whether the user is an Admin of the Enterprise Grid organization.",
-						Computed:    true,
-					},
-					"is_owner": schema.BoolAttribute{
-						Description: "Indicates whether the user is an Owner of the Enterprise Grid organization.",
-						Computed:    true,
-					},
-					"teams": schema.ListAttribute{
-						ElementType: types.StringType,
-						Description: "An array of workspace IDs that are in the Enterprise Grid organization.",
-						Computed:    true,
-					},
+		},
+		"enterprise_user": schema.SingleNestedAttribute{
+			Description: "An object containing info related to an Enterprise Grid user.",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"enterprise_id": schema.StringAttribute{
+					Description: "A unique ID for the Enterprise Grid organization this user belongs to.",
+					Computed:    true,
+				},
+				"enterprise_name": schema.StringAttribute{
+					Description: "A display name for the Enterprise Grid organization.",
+					Computed:    true,
+				},
+				"id": schema.StringAttribute{
+					Description: "This user's ID - some Grid users have a kind of dual identity — a local, workspace-centric user ID as well as a Grid-wise user ID, called the Enterprise user ID.",
+					Computed:    true,
+				},
+				"is_admin": schema.BoolAttribute{
+					Description: "Indicates whether the user is an Admin of the Enterprise Grid organization.",
+					Computed:    true,
+				},
+				"is_owner": schema.BoolAttribute{
+					Description: "Indicates whether the user is an Owner of the Enterprise Grid organization.",
+					Computed:    true,
+				},
+				"teams": schema.ListAttribute{
+					ElementType: types.StringType,
+					Description: "An array of workspace IDs that are in the Enterprise Grid organization.",
+					Computed:    true,
 				},
 			},
 		},
@@ -299,11 +386,47 @@ func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 // Read refreshes the Terraform state with the latest data.
 func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	tflog.Debug(ctx, "Preparing to read user data source")
-	var state userModel
+	var state userDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.client.checkScopes(d.requiredScopes(state)...)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	userResponse, err := d.client.GetUserInfo(state.ID.ValueString())
+	var userResponse *slack.User
+	var err error
+	switch {
+	case !state.Email.IsNull():
+		userResponse, err = d.client.getUserByEmail(state.Email.ValueString())
+		if isSlackError(err, "users_not_found") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("email"),
+				"User Not Found",
+				"No user has the email address "+state.Email.ValueString()+".",
+			)
+			return
+		}
+	case !state.Name.IsNull():
+		userResponse, err = d.findUserByName(state.Name.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	default:
+		userResponse, err = d.client.getUser(state.ID.ValueString())
+		if isSlackError(err, "user_not_found") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"User Not Found",
+				"No user has the ID "+state.ID.ValueString()+".",
+			)
+			return
+		}
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read User",
@@ -312,8 +435,153 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	// Map response body to model
+	var diags diag.Diagnostics
+	state.userModel, diags = newUserModel(ctx, userResponse)
+	resp.Diagnostics.Append(diags...)
+
+	if state.IncludeFields.ValueBool() || state.FieldLabels.ValueBool() {
+		state.Profile.Fields, diags = d.profileFields(ctx, userResponse.ID, state.FieldLabels.ValueBool())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Read user data source", map[string]any{"success": true})
+}
+
+// requiredScopes lists the scopes needed to look up the configured user.
+func (d *userDataSource) requiredScopes(config userDataSourceModel) []scopeRequirement {
+	requirements := d.lookupScopes(config.userModel)
+	if config.IncludeFields.ValueBool() {
+		requirements = append(requirements, requireScope(path.Root("include_fields"), "users.profile:read"))
+	}
+	if config.FieldLabels.ValueBool() {
+		requirements = append(requirements, requireScope(path.Root("field_labels"), "users.profile:read"))
+	}
+	return requirements
+}
+
+// lookupScopes lists the scopes needed to find the configured user.
+func (d *userDataSource) lookupScopes(config userModel) []scopeRequirement {
+	switch {
+	case !config.Email.IsNull():
+		return []scopeRequirement{
+			requireScope(path.Root("email"), "users:read"),
+			requireScope(path.Root("email"), "users:read.email"),
+		}
+	case !config.Name.IsNull():
+		return []scopeRequirement{requireScope(path.Root("name"), "users:read")}
+	default:
+		return []scopeRequirement{requireScope(path.Root("id"), "users:read")}
+	}
+}
+
+// profileFields reads the user's custom profile fields from
+// users.profile.get, keyed by label from team.profile.get when labels is set.
+func (d *userDataSource) profileFields(ctx context.Context, userID string, labels bool) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	profile, err := d.client.GetUserProfile(&slack.GetUserProfileParameters{UserID: userID})
+	if err != nil {
+		diags.AddError(
+			"Unable to Read User Profile",
+			err.Error(),
+		)
+		return types.MapNull(userProfileFieldType), diags
+	}
+
+	var names map[string]string
+	if labels {
+		team, err := d.client.getTeamProfile()
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Team Profile",
+				err.Error(),
+			)
+			return types.MapNull(userProfileFieldType), diags
+		}
+		names = make(map[string]string, len(team.Fields))
+		for _, field := range team.Fields {
+			names[field.ID] = field.Label
+		}
+	}
+
+	return newUserProfileFieldsValue(ctx, profile.Fields.ToMap(), names)
+}
+
+// newUserProfileFieldsValue maps custom profile fields to the fields
+// attribute, keyed by their name in names, or by ID for those not named or
+// sharing their name with another field.
+func newUserProfileFieldsValue(ctx context.Context, fields map[string]slack.UserProfileCustomField, names map[string]string) (types.Map, diag.Diagnostics) {
+	uses := make(map[string]int, len(names))
+	for _, name := range names {
+		uses[name]++
+	}
+
+	models := make(map[string]userProfileFieldModel, len(fields))
+	for id, field := range fields {
+		key := id
+		if name := names[id]; name != "" && uses[name] == 1 {
+			key = name
+		}
+		models[key] = userProfileFieldModel{
+			Value: types.StringValue(field.Value),
+			Alt:   types.StringValue(field.Alt),
+		}
+	}
+	return types.MapValueFrom(ctx, userProfileFieldType, models)
+}
+
+// findUserByName scans users.list for the one user with the given username.
+// Lookup failures are reported as diagnostics, API failures as an error.
+func (d *userDataSource) findUserByName(name string, diags *diag.Diagnostics) (*slack.User, error) {
+	users, err := d.client.listUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []slack.User
+	for i := range users {
+		if users[i].Name == name {
+			matches = append(matches, users[i])
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddAttributeError(
+			path.Root("name"),
+			"User Not Found",
+			"No user has the username "+name+".",
+		)
+		return nil, nil
+	case 1:
+		return &matches[0], nil
+	}
+
+	ids := make([]string, 0, len(matches))
+	for _, match := range matches {
+		ids = append(ids, match.ID)
+	}
+	diags.AddAttributeError(
+		path.Root("name"),
+		"Multiple Users Found",
+		"The username "+name+" matches more than one user ("+strings.Join(ids, ", ")+"). Look the user up by id or email instead.",
+	)
+	return nil, nil
+}
+
+// newUserModel maps a Slack user to the shared user model.
+func newUserModel(ctx context.Context, userResponse *slack.User) (userModel, diag.Diagnostics) {
+	fields, diags := newUserProfileFieldsValue(ctx, userResponse.Profile.Fields.ToMap(), nil)
+
 	userProfileData := userProfileModel{
 		DisplayName:           types.StringValue(userResponse.Profile.DisplayName),
+		Fields:                fields,
 		DisplayNameNormalized: types.StringValue(userResponse.Profile.DisplayNameNormalized),
 		FirstName:             types.StringValue(userResponse.Profile.FirstName),
 		Image192:              types.StringValue(userResponse.Profile.Image192),
@@ -334,8 +602,8 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		Title:                 types.StringValue(userResponse.Profile.Title),
 	}
 
-	enterpriseTeams, diags := types.ListValueFrom(ctx, types.StringType, userResponse.Enterprise.Teams)
-	resp.Diagnostics.Append(diags...)
+	enterpriseTeams, teamsDiags := types.ListValueFrom(ctx, types.StringType, userResponse.Enterprise.Teams)
+	diags.Append(teamsDiags...)
 
 	enterpriseUserProfileData := enterpriseUserModel{
 		EnterpriseID:   types.StringValue(userResponse.Enterprise.EnterpriseID),
@@ -346,10 +614,10 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		Teams:          enterpriseTeams,
 	}
 
-	// Map response body to model
-	state = userModel{
+	return userModel{
 		Color:             types.StringValue(userResponse.Color),
 		Deleted:           types.BoolValue(userResponse.Deleted),
+		Email:             types.StringValue(userResponse.Profile.Email),
 		EnterpriseUser:    &enterpriseUserProfileData,
 		ID:                types.StringValue(userResponse.ID),
 		IsAdmin:           types.BoolValue(userResponse.IsAdmin),
@@ -368,9 +636,5 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		TZLabel:           types.StringValue(userResponse.TZLabel),
 		TZOffset:          types.Int64Value(int64(userResponse.TZOffset)),
 		Updated:           types.StringValue(userResponse.Updated.Time().Format("Mon Jan 2 15:04:05 MST 2006")),
-	}
-
-	// Set state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-	tflog.Debug(ctx, "Read user data source", map[string]any{"success": true})
+	}, diags
 }
@@ -1,9 +1,14 @@
 package slack
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"sync"
 	"testing"
 
+	"github.com/slack-go/slack"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -14,14 +19,92 @@ func TestAccUserDataSource(t *testing.T) {
 			{
 				Config: providerConfig + fmt.Sprintf(`
 data "slack_user" "test" {
-	id = "%s"
+	id             = "%s"
+	include_fields = true
+}
+
+data "slack_user" "by_email" {
+	email = data.slack_user.test.email
+}
+
+data "slack_user" "by_name" {
+	name = data.slack_user.test.name
 }
-`, slackTestUserID),
+
+data "slack_user" "labeled" {
+	id           = "%s"
+	field_labels = true
+}
+`, slackTestUserID, slackTestUserID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify placeholder id attribute
 					resource.TestCheckResourceAttrSet("data.slack_user.test", "id"),
+					resource.TestCheckResourceAttrPair("data.slack_user.by_email", "id", "data.slack_user.test", "id"),
+					resource.TestCheckResourceAttrPair("data.slack_user.by_name", "id", "data.slack_user.test", "id"),
+					resource.TestCheckResourceAttrPair("data.slack_user.labeled", "profile.fields.%", "data.slack_user.test", "profile.fields.%"),
 				),
 			},
 		},
 	})
 }
+
+func TestNewUserProfileFieldsValue(t *testing.T) {
+	fields := map[string]slack.UserProfileCustomField{
+		"Xf06054AAA": {Value: "U0123ABCD", Alt: ""},
+		"Xf06054BBB": {Value: "https://example.com/team", Alt: "Team page"},
+		"Xf06054CCC": {Value: "Berlin"},
+		"Xf06054DDD": {Value: "Remote"},
+	}
+	names := map[string]string{
+		"Xf06054AAA": "Manager",
+		"Xf06054CCC": "Office",
+		"Xf06054DDD": "Office",
+	}
+
+	value, diags := newUserProfileFieldsValue(context.Background(), fields, names)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	var models map[string]userProfileFieldModel
+	if diags := value.ElementsAs(context.Background(), &models, false); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(models) != 4 {
+		t.Fatalf("expected four fields, got %v", models)
+	}
+	if manager := models["Manager"]; manager.Value.ValueString() != "U0123ABCD" {
+		t.Errorf("expected the Manager field to be keyed by label, got %v", models)
+	}
+	if team := models["Xf06054BBB"]; team.Alt.ValueString() != "Team page" {
+		t.Errorf("expected the unnamed field to be keyed by ID, got %v", models)
+	}
+	if models["Xf06054CCC"].Value.ValueString() != "Berlin" || models["Xf06054DDD"].Value.ValueString() != "Remote" {
+		t.Errorf("expected fields sharing a label to be keyed by ID, got %v", models)
+	}
+}
+
+func TestGetTeamProfile(t *testing.T) {
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"team.profile.get": func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"ok":true,"profile":{"fields":[{"id":"Xf06054AAA","label":"Manager"}]}}`)
+		},
+	})
+	client := server.client("xoxb-test")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if profile, err := client.getTeamProfile(); err != nil || len(profile.Fields) != 1 {
+				t.Errorf("expected the team profile, got %v, %v", profile, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := len(server.requestsTo("team.profile.get")); n != 1 {
+		t.Errorf("expected team.profile.get to be called once, got %d", n)
+	}
+}
@@ -0,0 +1,164 @@
+package slack
+
+import (
+	"regexp"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// userFilterAttributes describes the optional attributes of a user filter,
+// shared by the slack_users data source and the slack_usergroup_members
+// resource.
+var userFilterAttributes = []struct {
+	name        string
+	isBool      bool
+	description string
+}{
+	{"is_bot", true, "Only match users whose is_bot flag has this value."},
+	{"deleted", true, "Only match users whose deleted flag has this value."},
+	{"is_restricted", true, "Only match users whose is_restricted flag has this value."},
+	{"team_id", false, "Only match users belonging to this workspace."},
+	{"name_regex", false, "Only match users whose name, real name or display name matches this regular expression."},
+	{"email_regex", false, "Only match users whose email address matches this regular expression."},
+	{"title_regex", false, "Only match users whose profile title matches this regular expression."},
+}
+
+// userFilterDataSourceAttributes returns the user filter attributes for a
+// data source schema.
+func userFilterDataSourceAttributes() map[string]datasourceschema.Attribute {
+	attributes := map[string]datasourceschema.Attribute{}
+	for _, a := range userFilterAttributes {
+		if a.isBool {
+			attributes[a.name] = datasourceschema.BoolAttribute{Description: a.description, Optional: true}
+		} else {
+			attributes[a.name] = datasourceschema.StringAttribute{Description: a.description, Optional: true}
+		}
+	}
+	return attributes
+}
+
+// userFilterResourceAttributes returns the user filter attributes for a
+// resource schema.
+func userFilterResourceAttributes() map[string]resourceschema.Attribute {
+	attributes := map[string]resourceschema.Attribute{}
+	for _, a := range userFilterAttributes {
+		if a.isBool {
+			attributes[a.name] = resourceschema.BoolAttribute{Description: a.description, Optional: true}
+		} else {
+			attributes[a.name] = resourceschema.StringAttribute{Description: a.description, Optional: true}
+		}
+	}
+	return attributes
+}
+
+// userFilterModel maps the user filter schema data.
+type userFilterModel struct {
+	IsBot        types.Bool   `tfsdk:"is_bot"`
+	Deleted      types.Bool   `tfsdk:"deleted"`
+	IsRestricted types.Bool   `tfsdk:"is_restricted"`
+	TeamID       types.String `tfsdk:"team_id"`
+	NameRegex    types.String `tfsdk:"name_regex"`
+	EmailRegex   types.String `tfsdk:"email_regex"`
+	TitleRegex   types.String `tfsdk:"title_regex"`
+}
+
+// userFilter is a compiled userFilterModel.
+type userFilter struct {
+	isBot        *bool
+	deleted      *bool
+	isRestricted *bool
+	teamID       string
+	name         *regexp.Regexp
+	email        *regexp.Regexp
+	title        *regexp.Regexp
+}
+
+// compile validates the filter and prepares it for matching. The path is
+// used to report invalid regular expressions.
+func (m *userFilterModel) compile(p path.Path) (*userFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	filter := &userFilter{
+		isBot:        optionalBool(m.IsBot),
+		deleted:      optionalBool(m.Deleted),
+		isRestricted: optionalBool(m.IsRestricted),
+		teamID:       m.TeamID.ValueString(),
+	}
+
+	filter.name = compileRegex(m.NameRegex, p.AtName("name_regex"), &diags)
+	filter.email = compileRegex(m.EmailRegex, p.AtName("email_regex"), &diags)
+	filter.title = compileRegex(m.TitleRegex, p.AtName("title_regex"), &diags)
+
+	return filter, diags
+}
+
+// isKnown reports whether every configured criterion is known.
+func (m *userFilterModel) isKnown() bool {
+	return !m.IsBot.IsUnknown() &&
+		!m.Deleted.IsUnknown() &&
+		!m.IsRestricted.IsUnknown() &&
+		!m.TeamID.IsUnknown() &&
+		!m.NameRegex.IsUnknown() &&
+		!m.EmailRegex.IsUnknown() &&
+		!m.TitleRegex.IsUnknown()
+}
+
+// match reports whether the user satisfies every configured criterion.
+func (f *userFilter) match(user userModel) bool {
+	if f.isBot != nil && user.IsBot.ValueBool() != *f.isBot {
+		return false
+	}
+	if f.deleted != nil && user.Deleted.ValueBool() != *f.deleted {
+		return false
+	}
+	if f.isRestricted != nil && user.IsRestricted.ValueBool() != *f.isRestricted {
+		return false
+	}
+	if f.teamID != "" && user.TeamID.ValueString() != f.teamID {
+		return false
+	}
+	if f.name != nil &&
+		!f.name.MatchString(user.Name.ValueString()) &&
+		!f.name.MatchString(user.RealName.ValueString()) &&
+		!f.name.MatchString(user.Profile.DisplayName.ValueString()) {
+		return false
+	}
+	if f.email != nil && !f.email.MatchString(user.Email.ValueString()) {
+		return false
+	}
+	if f.title != nil && !f.title.MatchString(user.Profile.Title.ValueString()) {
+		return false
+	}
+	return true
+}
+
+// optionalBool returns nil for null or unknown values.
+func optionalBool(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	v := value.ValueBool()
+	return &v
+}
+
+// compileRegex compiles a configured regular expression, adding an
+// attribute error to diags when it is invalid.
+func compileRegex(value types.String, p path.Path, diags *diag.Diagnostics) *regexp.Regexp {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	re, err := regexp.Compile(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			"Invalid Regular Expression",
+			err.Error(),
+		)
+		return nil
+	}
+	return re
+}
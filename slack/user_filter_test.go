@@ -0,0 +1,125 @@
+package slack
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUserFilterMatch(t *testing.T) {
+	engineer := userModel{
+		Name:         types.StringValue("jdoe"),
+		RealName:     types.StringValue("Jane Doe"),
+		Email:        types.StringValue("jane.doe@example.com"),
+		TeamID:       types.StringValue("T0001"),
+		IsBot:        types.BoolValue(false),
+		Deleted:      types.BoolValue(false),
+		IsRestricted: types.BoolValue(false),
+		Profile: &userProfileModel{
+			DisplayName: types.StringValue("jane"),
+			Title:       types.StringValue("Staff Engineer"),
+		},
+	}
+	bot := userModel{
+		Name:         types.StringValue("deploybot"),
+		RealName:     types.StringValue("Deploy Bot"),
+		Email:        types.StringValue(""),
+		TeamID:       types.StringValue("T0002"),
+		IsBot:        types.BoolValue(true),
+		Deleted:      types.BoolValue(false),
+		IsRestricted: types.BoolValue(false),
+		Profile: &userProfileModel{
+			DisplayName: types.StringValue("deploybot"),
+			Title:       types.StringValue(""),
+		},
+	}
+
+	tests := map[string]struct {
+		filter   userFilterModel
+		engineer bool
+		bot      bool
+	}{
+		"empty": {
+			filter:   userFilterModel{},
+			engineer: true,
+			bot:      true,
+		},
+		"not bot": {
+			filter:   userFilterModel{IsBot: types.BoolValue(false)},
+			engineer: true,
+			bot:      false,
+		},
+		"title": {
+			filter:   userFilterModel{TitleRegex: types.StringValue("(?i)engineer")},
+			engineer: true,
+			bot:      false,
+		},
+		"real name": {
+			filter:   userFilterModel{NameRegex: types.StringValue("^Deploy")},
+			engineer: false,
+			bot:      true,
+		},
+		"display name": {
+			filter:   userFilterModel{NameRegex: types.StringValue("^jane$")},
+			engineer: true,
+			bot:      false,
+		},
+		"email": {
+			filter:   userFilterModel{EmailRegex: types.StringValue("@example\\.com$")},
+			engineer: true,
+			bot:      false,
+		},
+		"team": {
+			filter:   userFilterModel{TeamID: types.StringValue("T0002")},
+			engineer: false,
+			bot:      true,
+		},
+		"deleted": {
+			filter:   userFilterModel{Deleted: types.BoolValue(true)},
+			engineer: false,
+			bot:      false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			filter, diags := test.filter.compile(path.Root("filter"))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got := filter.match(engineer); got != test.engineer {
+				t.Errorf("engineer: expected %t, got %t", test.engineer, got)
+			}
+			if got := filter.match(bot); got != test.bot {
+				t.Errorf("bot: expected %t, got %t", test.bot, got)
+			}
+		})
+	}
+}
+
+func TestUserFilterInvalidRegex(t *testing.T) {
+	filter := userFilterModel{TitleRegex: types.StringValue("(")}
+
+	_, diags := filter.compile(path.Root("filter"))
+	if !diags.HasError() {
+		t.Fatal("expected an error for an invalid regular expression")
+	}
+}
+
+func TestUserFilterAttributes(t *testing.T) {
+	dataSource := userFilterDataSourceAttributes()
+	resource := userFilterResourceAttributes()
+
+	model := reflect.TypeOf(userFilterModel{})
+	if len(dataSource) != model.NumField() || len(resource) != model.NumField() {
+		t.Fatalf("expected an attribute per model field, got %d and %d for %d fields", len(dataSource), len(resource), model.NumField())
+	}
+	for i := 0; i < model.NumField(); i++ {
+		name := model.Field(i).Tag.Get("tfsdk")
+		if dataSource[name] == nil || resource[name] == nil {
+			t.Errorf("expected both schemas to have the %s attribute", name)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package slack
+
+import (
+	"encoding/json"
+	"net/url"
+	"sync"
+
+	"github.com/slack-go/slack"
+	"golang.org/x/sync/singleflight"
+)
+
+// teamProfileCache holds the workspace's custom profile field definitions,
+// which do not change during a run, so that every data source keying fields
+// by label shares a single team.profile.get call.
+type teamProfileCache struct {
+	// group de-duplicates concurrent loads.
+	group singleflight.Group
+
+	mu      sync.RWMutex
+	profile *slack.TeamProfile
+}
+
+// getTeamProfile returns the workspace's custom profile field definitions
+// from team.profile.get, read once per client.
+func (c *slackClient) getTeamProfile() (*slack.TeamProfile, error) {
+	c.teamProfile.mu.RLock()
+	profile := c.teamProfile.profile
+	c.teamProfile.mu.RUnlock()
+	if profile != nil {
+		return profile, nil
+	}
+
+	result, err, _ := c.teamProfile.group.Do("team.profile.get", func() (any, error) {
+		profile, err := c.GetTeamProfile()
+		if err != nil {
+			return nil, err
+		}
+
+		c.teamProfile.mu.Lock()
+		defer c.teamProfile.mu.Unlock()
+		c.teamProfile.profile = profile
+		return profile, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*slack.TeamProfile), nil
+}
+
+// userProfileUpdate is the part of a profile users.profile.set changes.
+// Attributes left nil are not sent, and keep their value.
+type userProfileUpdate struct {
+	DisplayName *string                           `json:"display_name,omitempty"`
+	FirstName   *string                           `json:"first_name,omitempty"`
+	LastName    *string                           `json:"last_name,omitempty"`
+	Phone       *string                           `json:"phone,omitempty"`
+	Title       *string                           `json:"title,omitempty"`
+	Fields      map[string]userProfileFieldUpdate `json:"fields,omitempty"`
+}
+
+// userProfileFieldUpdate is the value of a custom profile field set through
+// users.profile.set.
+type userProfileFieldUpdate struct {
+	Value string `json:"value"`
+	Alt   string `json:"alt"`
+}
+
+// empty reports whether the update changes nothing.
+func (u userProfileUpdate) empty() bool {
+	return u.DisplayName == nil && u.FirstName == nil && u.LastName == nil &&
+		u.Phone == nil && u.Title == nil && len(u.Fields) == 0
+}
+
+// setUserProfile changes the profile of the user, through users.profile.set.
+// slack-go only sets the real name or the custom fields alone.
+func (c *slackClient) setUserProfile(userID string, update userProfileUpdate) error {
+	profile, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+
+	return c.call("users.profile.set", url.Values{
+		"user":    {userID},
+		"profile": {string(profile)},
+	}, &slack.SlackResponse{})
+}
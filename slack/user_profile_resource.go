@@ -0,0 +1,331 @@
+package slack
+
+import (
+	"context"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &userProfileResource{}
+	_ resource.ResourceWithConfigure   = &userProfileResource{}
+	_ resource.ResourceWithImportState = &userProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &userProfileResource{}
+)
+
+// NewUserProfileResource is a helper function to simplify the provider implementation.
+func NewUserProfileResource() resource.Resource {
+	return &userProfileResource{}
+}
+
+// userProfileResource is the resource implementation.
+type userProfileResource struct {
+	client *slackClient
+}
+
+// userProfileResourceModel maps the resource schema data.
+type userProfileResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	UserID      types.String `tfsdk:"user_id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	FirstName   types.String `tfsdk:"first_name"`
+	LastName    types.String `tfsdk:"last_name"`
+	Phone       types.String `tfsdk:"phone"`
+	Title       types.String `tfsdk:"title"`
+	Fields      types.Map    `tfsdk:"fields"`
+}
+
+// profileUpdate returns the update setting the attributes set in the model,
+// which must be the configuration so that attributes left out keep their
+// value in Slack.
+func (m userProfileResourceModel) profileUpdate(ctx context.Context) (userProfileUpdate, diag.Diagnostics) {
+	var update userProfileUpdate
+	update.DisplayName = m.DisplayName.ValueStringPointer()
+	update.FirstName = m.FirstName.ValueStringPointer()
+	update.LastName = m.LastName.ValueStringPointer()
+	update.Phone = m.Phone.ValueStringPointer()
+	update.Title = m.Title.ValueStringPointer()
+
+	if m.Fields.IsNull() {
+		return update, nil
+	}
+	var fields map[string]string
+	diags := m.Fields.ElementsAs(ctx, &fields, false)
+	update.Fields = make(map[string]userProfileFieldUpdate, len(fields))
+	for id, value := range fields {
+		update.Fields[id] = userProfileFieldUpdate{Value: value}
+	}
+	return update, diags
+}
+
+// setComputed copies the values read from Slack into the resource model.
+// Only the custom fields already in the model are read back, as the others
+// are not managed by Terraform.
+func (m *userProfileResourceModel) setComputed(ctx context.Context, userID string, profile *slack.UserProfile) diag.Diagnostics {
+	m.ID = types.StringValue(userID)
+	m.UserID = types.StringValue(userID)
+	m.DisplayName = types.StringValue(profile.DisplayName)
+	m.FirstName = types.StringValue(profile.FirstName)
+	m.LastName = types.StringValue(profile.LastName)
+	m.Phone = types.StringValue(profile.Phone)
+	m.Title = types.StringValue(profile.Title)
+
+	if m.Fields.IsNull() || m.Fields.IsUnknown() {
+		return nil
+	}
+	var fields map[string]string
+	diags := m.Fields.ElementsAs(ctx, &fields, false)
+	if diags.HasError() {
+		return diags
+	}
+	current := profile.Fields.ToMap()
+	for id := range fields {
+		fields[id] = current[id].Value
+	}
+	var setDiags diag.Diagnostics
+	m.Fields, setDiags = types.MapValueFrom(ctx, types.StringType, fields)
+	diags.Append(setDiags...)
+	return diags
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*slackClients).client(tokenTypeAdmin, &resp.Diagnostics)
+}
+
+// Metadata returns the resource type name.
+func (r *userProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_profile"
+}
+
+// Schema defines the schema for the resource.
+func (r *userProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the profile of an existing user, such as titles and managers sourced from an HR system. " +
+			"Only the attributes and custom fields set in the configuration are managed, and changes made to them outside of Terraform " +
+			"are reverted on the next apply. Removing an attribute or field from the configuration, or destroying this resource, " +
+			"leaves its value untouched. Setting the profile of other users requires an admin token on a paid workspace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier for this user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The display name the user has chosen to identify themselves by in their workspace profile.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"first_name": schema.StringAttribute{
+				Description: "The user's first name.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_name": schema.StringAttribute{
+				Description: "The user's last name.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"phone": schema.StringAttribute{
+				Description: "The user's phone number, in any format.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The user's title.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fields": schema.MapAttribute{
+				Description: "The values of custom profile fields, keyed by field ID, such as Xf06054AAA. " +
+					"Fields of the user type, such as a manager, take a user ID. An empty value clears the field.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// ModifyPlan fails the plan early when the token lacks the scopes needed to
+// read and set profiles.
+func (r *userProfileResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkScopes(
+		requireScope(path.Empty(), "users.profile:read"),
+		requireScope(path.Empty(), "users.profile:write"),
+	)...)
+}
+
+// Create sets the configured profile attributes and the initial Terraform
+// state.
+func (r *userProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create user profile resource")
+	var plan, config userProfileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, diags := r.setProfile(ctx, plan.UserID.ValueString(), config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setComputed(ctx, plan.UserID.ValueString(), profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Created user profile resource", map[string]any{"success": true})
+}
+
+// setProfile sets the profile attributes set in the configuration, and
+// returns the profile as read back from Slack.
+func (r *userProfileResource) setProfile(ctx context.Context, userID string, config userProfileResourceModel) (*slack.UserProfile, diag.Diagnostics) {
+	update, diags := config.profileUpdate(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if !update.empty() {
+		if err := r.client.setUserProfile(userID, update); err != nil {
+			diags.AddError(
+				"Unable to Set User Profile",
+				err.Error(),
+			)
+			return nil, diags
+		}
+	}
+
+	profile, err := r.client.GetUserProfile(&slack.GetUserProfileParameters{UserID: userID})
+	if err != nil {
+		diags.AddError(
+			"Unable to Read User Profile",
+			err.Error(),
+		)
+		return nil, diags
+	}
+	return profile, diags
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read user profile resource")
+	var state userProfileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.GetUserProfile(&slack.GetUserProfileParameters{
+		UserID: state.UserID.ValueString(),
+	})
+	if isSlackError(err, "user_not_found") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read User Profile",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.setComputed(ctx, state.UserID.ValueString(), profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Read user profile resource", map[string]any{"success": true})
+}
+
+// Update sets the configured profile attributes and the updated Terraform
+// state on success.
+func (r *userProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update user profile resource")
+	var plan, config userProfileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, diags := r.setProfile(ctx, plan.UserID.ValueString(), config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setComputed(ctx, plan.UserID.ValueString(), profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Updated user profile resource", map[string]any{"success": true})
+}
+
+// Delete removes the Terraform state, leaving the profile untouched.
+func (r *userProfileResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleted user profile resource, leaving the profile untouched", map[string]any{"success": true})
+}
+
+// ImportState imports the profile of an existing user by its ID. Custom
+// fields are not imported, as they are only managed once configured.
+func (r *userProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), req.ID)...)
+}
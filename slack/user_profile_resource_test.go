@@ -0,0 +1,48 @@
+package slack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccUserProfileResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "slack_user_profile" "test" {
+	user_id = "%s"
+	title   = "Set by Terraform"
+}
+`, slackTestUserID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_user_profile.test", "id", slackTestUserID),
+					resource.TestCheckResourceAttr("slack_user_profile.test", "title", "Set by Terraform"),
+					resource.TestCheckResourceAttrSet("slack_user_profile.test", "display_name"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "slack_user_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "slack_user_profile" "test" {
+	user_id = "%s"
+	title   = "Updated by Terraform"
+}
+`, slackTestUserID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_user_profile.test", "title", "Updated by Terraform"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,79 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSetUserProfile(t *testing.T) {
+	server := newTestServer(t, nil)
+	client := server.client("xoxp-admin")
+
+	config := userProfileResourceModel{
+		Title:       types.StringValue("Staff Engineer"),
+		Phone:       types.StringValue(""),
+		DisplayName: types.StringNull(),
+		FirstName:   types.StringNull(),
+		LastName:    types.StringNull(),
+		Fields: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"Xf06054AAA": types.StringValue("U0123ABCD"),
+		}),
+	}
+	update, diags := config.profileUpdate(context.Background())
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if err := client.setUserProfile("U0456EFGH", update); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := server.requestsTo("")
+	if len(requests) != 1 {
+		t.Fatalf("expected one request, got %v", requests)
+	}
+	set := requests[0]
+	if set.method != "users.profile.set" || set.form.Get("user") != "U0456EFGH" || set.form.Get("token") != "xoxp-admin" {
+		t.Errorf("unexpected users.profile.set request %+v", set)
+	}
+
+	// Attributes left out of the configuration are not sent, while empty
+	// ones clear the value.
+	var profile map[string]any
+	if err := json.Unmarshal([]byte(set.form.Get("profile")), &profile); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]any{
+		"title": "Staff Engineer",
+		"phone": "",
+		"fields": map[string]any{
+			"Xf06054AAA": map[string]any{"value": "U0123ABCD", "alt": ""},
+		},
+	}
+	got, _ := json.Marshal(profile)
+	want, _ := json.Marshal(expected)
+	if string(got) != string(want) {
+		t.Errorf("expected profile %s, got %s", want, got)
+	}
+}
+
+func TestUserProfileUpdateEmpty(t *testing.T) {
+	config := userProfileResourceModel{
+		DisplayName: types.StringNull(),
+		FirstName:   types.StringNull(),
+		LastName:    types.StringNull(),
+		Phone:       types.StringNull(),
+		Title:       types.StringNull(),
+		Fields:      types.MapNull(types.StringType),
+	}
+	update, diags := config.profileUpdate(context.Background())
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !update.empty() {
+		t.Errorf("expected an empty update, got %+v", update)
+	}
+}
@@ -0,0 +1,259 @@
+package slack
+
+import (
+	"context"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &usergroupDataSource{}
+	_ datasource.DataSourceWithConfigure        = &usergroupDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &usergroupDataSource{}
+)
+
+// NewUsergroupDataSource is a helper function to simplify the provider implementation.
+func NewUsergroupDataSource() datasource.DataSource {
+	return &usergroupDataSource{}
+}
+
+// usergroupDataSource is the data source implementation.
+type usergroupDataSource struct {
+	client *slackClient
+}
+
+type usergroupModel struct {
+	ID          types.String         `tfsdk:"id"`
+	AutoType    types.String         `tfsdk:"auto_type"`
+	CreatedBy   types.String         `tfsdk:"created_by"`
+	DateCreate  types.String         `tfsdk:"date_create"`
+	DateDelete  types.String         `tfsdk:"date_delete"`
+	DateUpdate  types.String         `tfsdk:"date_update"`
+	DeletedBy   types.String         `tfsdk:"deleted_by"`
+	Description types.String         `tfsdk:"description"`
+	Handle      types.String         `tfsdk:"handle"`
+	IsExternal  types.Bool           `tfsdk:"is_external"`
+	Name        types.String         `tfsdk:"name"`
+	Prefs       *usergroupPrefsModel `tfsdk:"prefs"`
+	TeamID      types.String         `tfsdk:"team_id"`
+	UpdatedBy   types.String         `tfsdk:"updated_by"`
+	UserCount   types.Int64          `tfsdk:"user_count"`
+	Users       types.List           `tfsdk:"users"`
+}
+
+type usergroupPrefsModel struct {
+	Channels types.List `tfsdk:"channels"`
+	Groups   types.List `tfsdk:"groups"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *usergroupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*slackClients).client(tokenTypeBot, &resp.Diagnostics)
+
+}
+
+// Metadata returns the data source type name.
+func (d *usergroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usergroup"
+}
+
+// ConfigValidators ensures that exactly one lookup key is configured.
+func (d *usergroupDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("handle"),
+		),
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *usergroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch a user group by ID or handle.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier for this user group. Conflicts with handle.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"handle": schema.StringAttribute{
+				Description: "The handle used to mention the user group, without the leading @. Conflicts with id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"auto_type": schema.StringAttribute{
+				Description: "Indicates whether the user group is an automatic group such as admins or owners.",
+				Computed:    true,
+			},
+			"created_by": schema.StringAttribute{
+				Description: "The ID for the user that created the user group.",
+				Computed:    true,
+			},
+			"date_create": schema.StringAttribute{
+				Description: "A Unix timestamp indicating when the user group was created.",
+				Computed:    true,
+			},
+			"date_delete": schema.StringAttribute{
+				Description: "A Unix timestamp indicating when the user group was disabled. Null for an active user group.",
+				Computed:    true,
+			},
+			"date_update": schema.StringAttribute{
+				Description: "A Unix timestamp indicating when the user group was last updated.",
+				Computed:    true,
+			},
+			"deleted_by": schema.StringAttribute{
+				Description: "The ID for the user that disabled the user group.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The user group's description.",
+				Computed:    true,
+			},
+			"is_external": schema.BoolAttribute{
+				Description: "Indicates whether the user group is shared from another workspace.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The user group's name.",
+				Computed:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Description: "Identifier for the workspace the user group belongs to.",
+				Computed:    true,
+			},
+			"updated_by": schema.StringAttribute{
+				Description: "The ID for the user that last updated the user group.",
+				Computed:    true,
+			},
+			"user_count": schema.Int64Attribute{
+				Description: "The number of users in the user group.",
+				Computed:    true,
+			},
+			"users": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "The IDs of the users in the user group.",
+				Computed:    true,
+			},
+			"prefs": schema.SingleNestedAttribute{
+				Description: "The user group's preferences.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"channels": schema.ListAttribute{
+						ElementType: types.StringType,
+						Description: "The IDs of the channels members are added to by default.",
+						Computed:    true,
+					},
+					"groups": schema.ListAttribute{
+						ElementType: types.StringType,
+						Description: "The IDs of the private channels members are added to by default.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usergroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read user group data source")
+	var state usergroupModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.client.checkScopes(requireScope(path.Empty(), "usergroups:read"))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userGroupResponse, err := findUserGroup(d.client, func(userGroup slack.UserGroup) bool {
+		if !state.ID.IsNull() {
+			return userGroup.ID == state.ID.ValueString()
+		}
+		return userGroup.Handle == state.Handle.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read User Group",
+			err.Error(),
+		)
+		return
+	}
+	if userGroupResponse == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Find User Group",
+			"No user group matches the configured id or handle.",
+		)
+		return
+	}
+
+	// Map response body to model
+	var diags diag.Diagnostics
+	state, diags = newUsergroupModel(ctx, userGroupResponse)
+	resp.Diagnostics.Append(diags...)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Read user group data source", map[string]any{"success": true})
+}
+
+// newUsergroupModel maps a Slack user group to the shared user group model.
+func newUsergroupModel(ctx context.Context, userGroupResponse *slack.UserGroup) (usergroupModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	users, d := types.ListValueFrom(ctx, types.StringType, userGroupResponse.Users)
+	diags.Append(d...)
+
+	channels, d := types.ListValueFrom(ctx, types.StringType, userGroupResponse.Prefs.Channels)
+	diags.Append(d...)
+
+	groups, d := types.ListValueFrom(ctx, types.StringType, userGroupResponse.Prefs.Groups)
+	diags.Append(d...)
+
+	prefsData := usergroupPrefsModel{
+		Channels: channels,
+		Groups:   groups,
+	}
+
+	// An active user group has no deletion date.
+	dateDelete := types.StringNull()
+	if isUserGroupDisabled(userGroupResponse) {
+		dateDelete = types.StringValue(userGroupResponse.DateDelete.Time().Format("Mon Jan 2 15:04:05 MST 2006"))
+	}
+
+	return usergroupModel{
+		ID:          types.StringValue(userGroupResponse.ID),
+		AutoType:    types.StringValue(userGroupResponse.AutoType),
+		CreatedBy:   types.StringValue(userGroupResponse.CreatedBy),
+		DateCreate:  types.StringValue(userGroupResponse.DateCreate.Time().Format("Mon Jan 2 15:04:05 MST 2006")),
+		DateDelete:  dateDelete,
+		DateUpdate:  types.StringValue(userGroupResponse.DateUpdate.Time().Format("Mon Jan 2 15:04:05 MST 2006")),
+		DeletedBy:   types.StringValue(userGroupResponse.DeletedBy),
+		Description: types.StringValue(userGroupResponse.Description),
+		Handle:      types.StringValue(userGroupResponse.Handle),
+		IsExternal:  types.BoolValue(userGroupResponse.IsExternal),
+		Name:        types.StringValue(userGroupResponse.Name),
+		Prefs:       &prefsData,
+		TeamID:      types.StringValue(userGroupResponse.TeamID),
+		UpdatedBy:   types.StringValue(userGroupResponse.UpdatedBy),
+		UserCount:   types.Int64Value(int64(userGroupResponse.UserCount)),
+		Users:       users,
+	}, diags
+}
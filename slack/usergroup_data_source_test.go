@@ -0,0 +1,61 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccUsergroupDataSource(t *testing.T) {
+	name := "tf-acc-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "slack_usergroup" "test" {
+	name   = "%[1]s"
+	handle = "%[1]s"
+}
+
+data "slack_usergroup" "by_id" {
+	id = slack_usergroup.test.id
+}
+
+data "slack_usergroup" "by_handle" {
+	handle = slack_usergroup.test.handle
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.slack_usergroup.by_id", "handle", "slack_usergroup.test", "handle"),
+					resource.TestCheckResourceAttrPair("data.slack_usergroup.by_handle", "id", "slack_usergroup.test", "id"),
+					resource.TestCheckNoResourceAttr("data.slack_usergroup.by_id", "date_delete"),
+				),
+			},
+		},
+	})
+}
+
+func TestNewUsergroupModelDateDelete(t *testing.T) {
+	active, diags := newUsergroupModel(context.Background(), &slack.UserGroup{ID: "S1", DateCreate: 1700000000})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !active.DateDelete.IsNull() {
+		t.Errorf("expected no deletion date for an active user group, got %s", active.DateDelete)
+	}
+
+	disabled, diags := newUsergroupModel(context.Background(), &slack.UserGroup{ID: "S1", DateCreate: 1700000000, DateDelete: 1700000100})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if disabled.DateDelete.IsNull() {
+		t.Error("expected a deletion date for a disabled user group")
+	}
+}
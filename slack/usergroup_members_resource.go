@@ -0,0 +1,354 @@
+package slack
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &usergroupMembersResource{}
+	_ resource.ResourceWithConfigure        = &usergroupMembersResource{}
+	_ resource.ResourceWithConfigValidators = &usergroupMembersResource{}
+	_ resource.ResourceWithImportState      = &usergroupMembersResource{}
+	_ resource.ResourceWithModifyPlan       = &usergroupMembersResource{}
+)
+
+// NewUsergroupMembersResource is a helper function to simplify the provider implementation.
+func NewUsergroupMembersResource() resource.Resource {
+	return &usergroupMembersResource{}
+}
+
+// usergroupMembersResource is the resource implementation.
+type usergroupMembersResource struct {
+	client *slackClient
+}
+
+// usergroupMembersResourceModel maps the resource schema data.
+type usergroupMembersResourceModel struct {
+	ID          types.String     `tfsdk:"id"`
+	UsergroupID types.String     `tfsdk:"usergroup_id"`
+	Users       types.Set        `tfsdk:"users"`
+	Filter      *userFilterModel `tfsdk:"filter"`
+	Members     types.Set        `tfsdk:"members"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *usergroupMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*slackClients).client(tokenTypeUser, &resp.Diagnostics)
+}
+
+// Metadata returns the resource type name.
+func (r *usergroupMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usergroup_members"
+}
+
+// ConfigValidators ensures that at least one source of members is configured.
+func (r *usergroupMembersResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("users"),
+			path.MatchRoot("filter"),
+		),
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *usergroupMembersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the members of a user group from a list of user IDs and/or filters over all users. " +
+			"Destroying this resource leaves the membership untouched, since Slack does not allow empty user groups. " +
+			"Calls Slack with the provider's user token.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier for this resource. Same as usergroup_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"usergroup_id": schema.StringAttribute{
+				Description: "Identifier for the user group whose members are managed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The IDs of users that always belong in the user group.",
+				Optional:    true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "Add every user matching all of the configured criteria to the user group.",
+				Optional:    true,
+				Attributes:  userFilterResourceAttributes(),
+			},
+			"members": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The IDs of the users in the user group.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan computes the desired members from the configured users and
+// filter, so that the plan shows exactly who will be added or removed.
+func (r *usergroupMembersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compute on destroy, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan usergroupMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requirements := []scopeRequirement{
+		requireScope(path.Empty(), "usergroups:read"),
+		requireScope(path.Empty(), "usergroups:write"),
+	}
+	if plan.Filter != nil {
+		requirements = append(requirements, requireScope(path.Root("filter"), "users:read"))
+		if !plan.Filter.EmailRegex.IsNull() {
+			requirements = append(requirements, requireScope(path.Root("filter").AtName("email_regex"), "users:read.email"))
+		}
+	}
+	resp.Diagnostics.Append(r.client.checkScopes(requirements...)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Users may be known as a set while some of them are not, such as IDs of
+	// users looked up in the same apply.
+	if !isFullyKnown(plan.Users) || (plan.Filter != nil && !plan.Filter.isKnown()) {
+		return
+	}
+
+	members, diags := r.desiredMembers(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Members, diags = types.SetValueFrom(ctx, types.StringType, members)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *usergroupMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create user group members resource")
+	var plan usergroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.UsergroupID
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Created user group members resource", map[string]any{"success": true})
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *usergroupMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read user group members resource")
+	var state usergroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.client.GetUserGroupMembers(state.UsergroupID.ValueString())
+	if isSlackError(err, "no_such_subteam", "subteam_not_found") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read User Group Members",
+			err.Error(),
+		)
+		return
+	}
+
+	var diags diag.Diagnostics
+	state.Members, diags = types.SetValueFrom(ctx, types.StringType, append([]string{}, members...))
+	resp.Diagnostics.Append(diags...)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Read user group members resource", map[string]any{"success": true})
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *usergroupMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update user group members resource")
+	var plan usergroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Updated user group members resource", map[string]any{"success": true})
+}
+
+// Delete removes the Terraform state. The user group membership is left as is.
+func (r *usergroupMembersResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleted user group members resource, membership left unchanged", map[string]any{"success": true})
+}
+
+// ImportState imports the members of an existing user group by its ID.
+func (r *usergroupMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("usergroup_id"), req.ID)...)
+}
+
+// apply updates the user group members when they differ from the plan.
+func (r *usergroupMembersResource) apply(ctx context.Context, plan *usergroupMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	usergroupID := plan.UsergroupID.ValueString()
+
+	// The members are unknown when ModifyPlan could not compute them.
+	if plan.Members.IsUnknown() {
+		var members []string
+		members, diags = r.desiredMembers(ctx, plan)
+		if diags.HasError() {
+			return diags
+		}
+
+		var d diag.Diagnostics
+		plan.Members, d = types.SetValueFrom(ctx, types.StringType, members)
+		diags.Append(d...)
+	}
+
+	var desired []string
+	diags.Append(plan.Members.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := r.client.GetUserGroupMembers(usergroupID)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read User Group Members",
+			err.Error(),
+		)
+		return diags
+	}
+
+	if len(difference(desired, current)) == 0 && len(difference(current, desired)) == 0 {
+		tflog.Debug(ctx, "User group members are up to date", map[string]any{"usergroup_id": usergroupID})
+		return diags
+	}
+
+	if _, err := r.client.UpdateUserGroupMembers(usergroupID, strings.Join(desired, ",")); err != nil {
+		diags.AddError(
+			"Unable to Update User Group Members",
+			err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// isFullyKnown reports whether the set and every one of its elements are
+// known.
+func isFullyKnown(set types.Set) bool {
+	if set.IsUnknown() {
+		return false
+	}
+	for _, element := range set.Elements() {
+		if element.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// desiredMembers returns the sorted union of the configured users and the
+// users matching the filter. An empty union is an error, as Slack rejects
+// updating a user group to no members.
+func (r *usergroupMembersResource) desiredMembers(ctx context.Context, plan *usergroupMembersResourceModel) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	members := map[string]struct{}{}
+
+	if !plan.Users.IsNull() {
+		var users []string
+		diags.Append(plan.Users.ElementsAs(ctx, &users, false)...)
+		for _, user := range users {
+			members[user] = struct{}{}
+		}
+	}
+
+	if plan.Filter != nil {
+		filter, d := plan.Filter.compile(path.Root("filter"))
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		users, d := getFilteredUsers(ctx, r.client, filter)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, user := range users {
+			members[user.ID.ValueString()] = struct{}{}
+		}
+	}
+
+	if len(members) == 0 {
+		diags.AddError(
+			"No User Group Members",
+			"The configured users and filter match no users, but Slack does not allow emptying a user group. "+
+				"Destroy the user group instead, or change the users or filter.",
+		)
+		return nil, diags
+	}
+
+	out := make([]string, 0, len(members))
+	for member := range members {
+		out = append(out, member)
+	}
+	sort.Strings(out)
+
+	return out, diags
+}
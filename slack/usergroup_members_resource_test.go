@@ -0,0 +1,92 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccUsergroupMembersResource(t *testing.T) {
+	name := "tf-acc-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "slack_usergroup" "test" {
+	name   = "%[1]s"
+	handle = "%[1]s"
+}
+
+resource "slack_usergroup_members" "test" {
+	usergroup_id = slack_usergroup.test.id
+	users        = ["%[2]s"]
+}
+`, name, slackTestUserID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("slack_usergroup_members.test", "id", "slack_usergroup.test", "id"),
+					resource.TestCheckResourceAttr("slack_usergroup_members.test", "members.#", "1"),
+					resource.TestCheckTypeSetElemAttr("slack_usergroup_members.test", "members.*", slackTestUserID),
+				),
+			},
+		},
+	})
+}
+
+// testUsergroupMembersModifyPlan runs ModifyPlan for a plan with the given
+// users, which may be partially unknown, and no filter.
+func testUsergroupMembersModifyPlan(t *testing.T, users tftypes.Value) *fwresource.ModifyPlanResponse {
+	t.Helper()
+	ctx := context.Background()
+	r := &usergroupMembersResource{client: &slackClient{}}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["usergroup_id"] = tftypes.NewValue(tftypes.String, "S0TEST")
+	values["users"] = users
+	values["members"] = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+
+	resp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan}, resp)
+	return resp
+}
+
+func TestUsergroupMembersModifyPlanUnknownUser(t *testing.T) {
+	resp := testUsergroupMembersModifyPlan(t, tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "U0TEST"),
+		tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	}))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var members types.Set
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("members"), &members)...)
+	if !members.IsUnknown() {
+		t.Errorf("expected the members to be left unknown, got %v", members)
+	}
+}
+
+func TestUsergroupMembersModifyPlanEmpty(t *testing.T) {
+	resp := testUsergroupMembersModifyPlan(t, tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}))
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a user group with no members")
+	}
+}
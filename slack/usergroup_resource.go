@@ -0,0 +1,370 @@
+package slack
+
+import (
+	"context"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &usergroupResource{}
+	_ resource.ResourceWithConfigure   = &usergroupResource{}
+	_ resource.ResourceWithImportState = &usergroupResource{}
+	_ resource.ResourceWithModifyPlan  = &usergroupResource{}
+)
+
+// NewUsergroupResource is a helper function to simplify the provider implementation.
+func NewUsergroupResource() resource.Resource {
+	return &usergroupResource{}
+}
+
+// usergroupResource is the resource implementation.
+type usergroupResource struct {
+	client *slackClient
+}
+
+// usergroupResourceModel maps the resource schema data.
+type usergroupResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Handle      types.String `tfsdk:"handle"`
+	Description types.String `tfsdk:"description"`
+	Channels    types.Set    `tfsdk:"channels"`
+	CreatedBy   types.String `tfsdk:"created_by"`
+	DateCreate  types.String `tfsdk:"date_create"`
+	IsExternal  types.Bool   `tfsdk:"is_external"`
+	TeamID      types.String `tfsdk:"team_id"`
+}
+
+// setComputed copies the values read from Slack into the resource model.
+func (m *usergroupResourceModel) setComputed(ctx context.Context, userGroup usergroupModel) diag.Diagnostics {
+	var channels []string
+	diags := userGroup.Prefs.Channels.ElementsAs(ctx, &channels, false)
+
+	m.ID = userGroup.ID
+	m.Name = userGroup.Name
+	m.Handle = userGroup.Handle
+	m.Description = userGroup.Description
+	m.CreatedBy = userGroup.CreatedBy
+	m.DateCreate = userGroup.DateCreate
+	m.IsExternal = userGroup.IsExternal
+	m.TeamID = userGroup.TeamID
+
+	var d diag.Diagnostics
+	m.Channels, d = types.SetValueFrom(ctx, types.StringType, append([]string{}, channels...))
+	diags.Append(d...)
+
+	return diags
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *usergroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*slackClients).client(tokenTypeUser, &resp.Diagnostics)
+}
+
+// Metadata returns the resource type name.
+func (r *usergroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usergroup"
+}
+
+// Schema defines the schema for the resource.
+func (r *usergroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a user group. User groups are disabled on destroy, and a disabled user group " +
+			"with the same name or handle is re-enabled on create. Calls Slack with the provider's user token.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier for this user group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The user group's name.",
+				Required:    true,
+			},
+			"handle": schema.StringAttribute{
+				Description: "The handle used to mention the user group, without the leading @.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The user group's description. Removing it from the configuration clears it.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"channels": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The IDs of the channels members are added to by default. Removing them from the configuration clears them.",
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"created_by": schema.StringAttribute{
+				Description: "The ID for the user that created the user group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"date_create": schema.StringAttribute{
+				Description: "A Unix timestamp indicating when the user group was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_external": schema.BoolAttribute{
+				Description: "Indicates whether the user group is shared from another workspace.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "Identifier for the workspace the user group belongs to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan fails the plan early when the token lacks the scopes needed to
+// manage user groups.
+func (r *usergroupResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkScopes(
+		requireScope(path.Empty(), "usergroups:read"),
+		requireScope(path.Empty(), "usergroups:write"),
+	)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *usergroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create user group resource")
+	var plan usergroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var channels []string
+	if !plan.Channels.IsUnknown() {
+		resp.Diagnostics.Append(plan.Channels.ElementsAs(ctx, &channels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	userGroup, err := r.client.CreateUserGroup(slack.UserGroup{
+		Name:        plan.Name.ValueString(),
+		Handle:      plan.Handle.ValueString(),
+		Description: plan.Description.ValueString(),
+		Prefs:       slack.UserGroupPrefs{Channels: channels},
+	})
+	if isSlackError(err, "name_already_exists", "handle_already_exists") {
+		userGroup, err = r.enableExisting(ctx, &plan, channels)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create User Group",
+			err.Error(),
+		)
+		return
+	}
+
+	userGroupData, diags := newUsergroupModel(ctx, &userGroup)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(plan.setComputed(ctx, userGroupData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Created user group resource", map[string]any{"success": true})
+}
+
+// enableExisting re-enables a disabled user group with the planned name or
+// handle, and brings it in line with the plan.
+func (r *usergroupResource) enableExisting(ctx context.Context, plan *usergroupResourceModel, channels []string) (slack.UserGroup, error) {
+	existing, err := findUserGroup(r.client, func(userGroup slack.UserGroup) bool {
+		return isUserGroupDisabled(&userGroup) &&
+			(userGroup.Name == plan.Name.ValueString() ||
+				(plan.Handle.ValueString() != "" && userGroup.Handle == plan.Handle.ValueString()))
+	})
+	if err != nil {
+		return slack.UserGroup{}, err
+	}
+	if existing == nil {
+		return slack.UserGroup{}, slack.SlackErrorResponse{Err: "name_already_exists"}
+	}
+
+	tflog.Info(ctx, "Re-enabling disabled user group", map[string]any{"id": existing.ID})
+	if _, err := r.client.EnableUserGroup(existing.ID); err != nil {
+		return slack.UserGroup{}, err
+	}
+
+	return r.client.UpdateUserGroup(existing.ID, updateUserGroupOptions(plan, channels)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *usergroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read user group resource")
+	var state usergroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userGroup, err := findUserGroup(r.client, func(userGroup slack.UserGroup) bool {
+		return userGroup.ID == state.ID.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read User Group",
+			err.Error(),
+		)
+		return
+	}
+
+	// A disabled user group is treated as destroyed.
+	if userGroup == nil || isUserGroupDisabled(userGroup) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	userGroupData, diags := newUsergroupModel(ctx, userGroup)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(state.setComputed(ctx, userGroupData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Read user group resource", map[string]any{"success": true})
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *usergroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update user group resource")
+	var plan usergroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var channels []string
+	if !plan.Channels.IsUnknown() {
+		resp.Diagnostics.Append(plan.Channels.ElementsAs(ctx, &channels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	userGroup, err := r.client.UpdateUserGroup(plan.ID.ValueString(), updateUserGroupOptions(&plan, channels)...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update User Group",
+			err.Error(),
+		)
+		return
+	}
+
+	userGroupData, diags := newUsergroupModel(ctx, &userGroup)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(plan.setComputed(ctx, userGroupData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Updated user group resource", map[string]any{"success": true})
+}
+
+// Delete disables the user group and removes the Terraform state on success.
+func (r *usergroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete user group resource")
+	var state usergroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DisableUserGroup(state.ID.ValueString())
+	if err != nil && !isSlackError(err, "no_such_subteam", "subteam_not_found") {
+		resp.Diagnostics.AddError(
+			"Unable to Disable User Group",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted user group resource", map[string]any{"success": true})
+}
+
+// ImportState imports an existing user group by its ID.
+func (r *usergroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateUserGroupOptions builds the usergroups.update options for the known
+// values in the plan.
+func updateUserGroupOptions(plan *usergroupResourceModel, channels []string) []slack.UpdateUserGroupsOption {
+	options := []slack.UpdateUserGroupsOption{
+		slack.UpdateUserGroupsOptionName(plan.Name.ValueString()),
+	}
+
+	if !plan.Handle.IsUnknown() && !plan.Handle.IsNull() {
+		options = append(options, slack.UpdateUserGroupsOptionHandle(plan.Handle.ValueString()))
+	}
+
+	// A null description or channels is sent empty, so that values set
+	// before are cleared rather than kept.
+	if !plan.Description.IsUnknown() {
+		description := plan.Description.ValueString()
+		options = append(options, slack.UpdateUserGroupsOptionDescription(&description))
+	}
+
+	if !plan.Channels.IsUnknown() {
+		options = append(options, slack.UpdateUserGroupsOptionChannels(channels))
+	}
+
+	return options
+}
@@ -0,0 +1,77 @@
+package slack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccUsergroupResource(t *testing.T) {
+	name := "tf-acc-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "slack_usergroup" "test" {
+	name        = "%[1]s"
+	handle      = "%[1]s"
+	description = "Created by Terraform"
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("slack_usergroup.test", "id"),
+					resource.TestCheckResourceAttr("slack_usergroup.test", "handle", name),
+					resource.TestCheckResourceAttr("slack_usergroup.test", "description", "Created by Terraform"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "slack_usergroup.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "slack_usergroup" "test" {
+	name        = "%[1]s-renamed"
+	handle      = "%[1]s"
+	description = "Updated by Terraform"
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_usergroup.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("slack_usergroup.test", "description", "Updated by Terraform"),
+				),
+			},
+		},
+	})
+}
+
+func TestUpdateUserGroupOptionsClear(t *testing.T) {
+	plan := usergroupResourceModel{
+		Name:        types.StringValue("Test"),
+		Handle:      types.StringNull(),
+		Description: types.StringNull(),
+		Channels:    types.SetNull(types.StringType),
+	}
+
+	var params slack.UpdateUserGroupsParams
+	for _, option := range updateUserGroupOptions(&plan, nil) {
+		option(&params)
+	}
+	if params.Description == nil || *params.Description != "" {
+		t.Errorf("expected an empty description to be sent, got %v", params.Description)
+	}
+	if params.Channels == nil || len(*params.Channels) != 0 {
+		t.Errorf("expected empty channels to be sent, got %v", params.Channels)
+	}
+}
@@ -0,0 +1,31 @@
+package slack
+
+import (
+	"github.com/slack-go/slack"
+)
+
+// findUserGroup lists every user group, including disabled ones, and
+// returns the first one matching the given predicate, or nil.
+func findUserGroup(client *slackClient, match func(slack.UserGroup) bool) (*slack.UserGroup, error) {
+	userGroups, err := client.GetUserGroups(
+		slack.GetUserGroupsOptionIncludeCount(true),
+		slack.GetUserGroupsOptionIncludeDisabled(true),
+		slack.GetUserGroupsOptionIncludeUsers(true),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range userGroups {
+		if match(userGroups[i]) {
+			return &userGroups[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// isUserGroupDisabled reports whether the user group has been disabled.
+func isUserGroupDisabled(userGroup *slack.UserGroup) bool {
+	return userGroup.DateDelete != 0
+}
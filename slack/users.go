@@ -0,0 +1,44 @@
+package slack
+
+import (
+	"context"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// getFilteredUsers pages through users.list, or reads the read cache when it
+// is enabled, and returns every user matching the filter. A nil filter
+// matches every user.
+func getFilteredUsers(ctx context.Context, client *slackClient, filter *userFilter) ([]userModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The read cache holds the users of every team, so a team filter is
+	// sent to Slack instead.
+	var users []slack.User
+	var err error
+	if filter != nil && filter.teamID != "" {
+		users, err = client.GetUsers(slack.GetUsersOptionTeamID(filter.teamID))
+	} else {
+		users, err = client.listUsers()
+	}
+	if err != nil {
+		diags.AddError(
+			"Unable to List Users",
+			err.Error(),
+		)
+		return nil, diags
+	}
+
+	matches := []userModel{}
+	for i := range users {
+		user, d := newUserModel(ctx, &users[i])
+		diags.Append(d...)
+		if filter == nil || filter.match(user) {
+			matches = append(matches, user)
+		}
+	}
+
+	return matches, diags
+}
@@ -0,0 +1,118 @@
+package slack
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+// NewUsersDataSource is a helper function to simplify the provider implementation.
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// usersDataSource is the data source implementation.
+type usersDataSource struct {
+	client *slackClient
+}
+
+// usersDataSourceModel maps the data source schema data.
+type usersDataSourceModel struct {
+	ID     types.String     `tfsdk:"id"`
+	Filter *userFilterModel `tfsdk:"filter"`
+	Users  []userModel      `tfsdk:"users"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*slackClients).client(tokenTypeBot, &resp.Diagnostics)
+
+}
+
+// Metadata returns the data source type name.
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the schema for the data source.
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch every user in the workspace, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "Only return users matching all of the configured criteria.",
+				Optional:    true,
+				Attributes:  userFilterDataSourceAttributes(),
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The matching users.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read users data source")
+	var state usersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requirements := []scopeRequirement{requireScope(path.Empty(), "users:read")}
+	if state.Filter != nil && !state.Filter.EmailRegex.IsNull() {
+		requirements = append(requirements, requireScope(path.Root("filter").AtName("email_regex"), "users:read.email"))
+	}
+	resp.Diagnostics.Append(d.client.checkScopes(requirements...)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter *userFilter
+	if state.Filter != nil {
+		compiled, diags := state.Filter.compile(path.Root("filter"))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		filter = compiled
+	}
+
+	users, diags := getFilteredUsers(ctx, d.client, filter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	state.ID = types.StringValue("placeholder")
+	state.Users = users
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Read users data source", map[string]any{"success": true, "count": len(users)})
+}
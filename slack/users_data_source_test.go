@@ -0,0 +1,35 @@
+package slack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccUsersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "slack_user" "test" {
+	id = "%s"
+}
+
+data "slack_users" "test" {
+	filter = {
+		email_regex = "^${data.slack_user.test.email}$"
+	}
+}
+`, slackTestUserID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify placeholder id attribute
+					resource.TestCheckResourceAttrSet("data.slack_users.test", "id"),
+					resource.TestCheckResourceAttr("data.slack_users.test", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.slack_users.test", "users.0.id", slackTestUserID),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package slack
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ validator.String = blocksJSONValidator{}
+)
+
+// blocksJSON returns a validator that checks a string attribute holds a JSON
+// array of Block Kit blocks.
+func blocksJSON() validator.String {
+	return blocksJSONValidator{}
+}
+
+// blocksJSONValidator implements the blocksJSON validator.
+type blocksJSONValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v blocksJSONValidator) Description(_ context.Context) string {
+	return "value must be a JSON array of Block Kit blocks"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v blocksJSONValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks that the value parses as Block Kit blocks.
+func (v blocksJSONValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseBlocks(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Block Kit JSON",
+			"The value must be a JSON array of Block Kit blocks, each with a type, such as the blocks array "+
+				"exported by Block Kit Builder: "+err.Error(),
+		)
+	}
+}